@@ -2,11 +2,17 @@ package main
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/JoaoVitor615/algorithms-in-go/sorting"
 )
 
 func main() {
+	// Any argument switches to the non-interactive command-line mode
+	if len(os.Args) > 1 {
+		os.Exit(sorting.NewCLI(os.Stdin, os.Stdout, os.Stderr).Run(os.Args[1:]))
+	}
+
 	fmt.Println("Welcome to the Algorithms-in-Go Terminal! 🚀")
 	fmt.Println("Please choose a category to execute:")
 	fmt.Println("1. Sorting Algorithms")
@@ -0,0 +1,228 @@
+package pkg
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// ComplexityClass identifies the asymptotic growth of an algorithm's running time
+type ComplexityClass int
+
+const (
+	Linearithmic ComplexityClass = iota // O(n log n)
+	Linear                              // O(n)
+	Quadratic                           // O(n²)
+	FourThirds                          // O(n^(4/3)), Shell Sort with good gap sequences
+)
+
+// minFitSizes is the fewest distinct sizes FitComplexity accepts
+// With two points the power law passes through both exactly and each class is judged on a single time ratio
+const minFitSizes = 3
+
+// candidateClasses lists the fixed models tried by FitComplexity, from slowest to fastest growth
+var candidateClasses = []ComplexityClass{Linear, Linearithmic, Quadratic}
+
+// String returns the Big-O notation of the class
+func (c ComplexityClass) String() string {
+	switch c {
+	case Linear:
+		return "O(n)"
+	case Quadratic:
+		return "O(n²)"
+	case FourThirds:
+		return "O(n^(4/3))"
+	default:
+		return "O(n log n)"
+	}
+}
+
+// MarshalText encodes the class as its Big-O notation
+func (c ComplexityClass) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+// UnmarshalText decodes a Big-O notation produced by MarshalText
+func (c *ComplexityClass) UnmarshalText(text []byte) error {
+	class, err := ParseComplexityClass(string(text))
+	if err != nil {
+		return err
+	}
+	*c = class
+	return nil
+}
+
+// ParseComplexityClass maps a Big-O notation such as "O(n log n)" to its class
+// It returns an error when the notation does not match any known class
+func ParseComplexityClass(notation string) (ComplexityClass, error) {
+	normalized := strings.ToLower(strings.ReplaceAll(notation, " ", ""))
+
+	switch normalized {
+	case "o(n)", "o(n+k)", "o(d(n+b))":
+		return Linear, nil
+	case "o(nlogn)":
+		return Linearithmic, nil
+	case "o(n²)", "o(n^2)", "o(n*n)":
+		return Quadratic, nil
+	case "o(n^(4/3))", "o(n^4/3)", "o(n⁴ᐟ³)":
+		return FourThirds, nil
+	default:
+		return 0, fmt.Errorf("unknown complexity class %q", notation)
+	}
+}
+
+// Operations returns the theoretical number of operations for an input of size n
+func (c ComplexityClass) Operations(n int) float64 {
+	size := float64(n)
+
+	switch c {
+	case Linear:
+		return size
+	case Quadratic:
+		return size * size
+	case FourThirds:
+		return math.Pow(size, 4.0/3)
+	default:
+		if n < 2 {
+			return size
+		}
+		return size * math.Log2(size)
+	}
+}
+
+// ModelFit describes how well measured timings follow one growth model t = c·f(n)
+type ModelFit struct {
+	Model       string  `json:"model"`       // Big-O notation of the model
+	Coefficient float64 `json:"coefficient"` // c in nanoseconds per theoretical operation
+	RSquared    float64 `json:"r_squared"`   // goodness of fit in log space, 1 is a perfect fit
+}
+
+// ComplexityFit is the result of fitting benchmark timings against candidate models
+type ComplexityFit struct {
+	Class    ComplexityClass `json:"class"`     // best matching fixed class
+	RSquared float64         `json:"r_squared"` // goodness of fit of Class
+	Exponent float64         `json:"exponent"`  // k of the free power-law fit t = c·n^k
+	Models   []ModelFit      `json:"models"`    // every candidate, including the power law
+}
+
+// FitComplexity fits benchmark timings against O(n), O(n log n), O(n²) and a free n^k model
+// Fitting happens in log space so that small and large inputs weigh the same
+// It returns false when there are fewer than minFitSizes distinct sizes with positive timings
+func FitComplexity(results []BenchmarkResult) (ComplexityFit, bool) {
+	var logN, logT []float64
+	var sizes []int
+	distinct := make(map[int]bool)
+
+	for _, result := range results {
+		if result.Count < 2 || result.Duration <= 0 {
+			continue
+		}
+		distinct[result.Count] = true
+		sizes = append(sizes, result.Count)
+		logN = append(logN, math.Log(float64(result.Count)))
+		logT = append(logT, math.Log(float64(result.Duration.Nanoseconds())))
+	}
+
+	if len(distinct) < minFitSizes {
+		return ComplexityFit{}, false
+	}
+
+	fit := ComplexityFit{RSquared: math.Inf(-1)}
+
+	for _, class := range candidateClasses {
+		// log t = log c + log f(n), so log c is the mean residual
+		logF := make([]float64, len(sizes))
+		for i, n := range sizes {
+			logF[i] = math.Log(class.Operations(n))
+		}
+
+		logC := mean(subtract(logT, logF))
+		predicted := make([]float64, len(sizes))
+		for i := range predicted {
+			predicted[i] = logC + logF[i]
+		}
+
+		rSquared := coefficientOfDetermination(logT, predicted)
+		fit.Models = append(fit.Models, ModelFit{
+			Model:       class.String(),
+			Coefficient: math.Exp(logC),
+			RSquared:    rSquared,
+		})
+
+		if rSquared > fit.RSquared {
+			fit.Class = class
+			fit.RSquared = rSquared
+		}
+	}
+
+	// Free power law: ordinary least squares of log t on log n
+	meanN, meanT := mean(logN), mean(logT)
+	covariance := 0.0
+	for i := range logN {
+		covariance += (logN[i] - meanN) * (logT[i] - meanT)
+	}
+	exponent := covariance / (variance(logN) * float64(len(logN)))
+	intercept := meanT - exponent*meanN
+
+	predicted := make([]float64, len(sizes))
+	for i := range predicted {
+		predicted[i] = intercept + exponent*logN[i]
+	}
+
+	fit.Exponent = exponent
+	fit.Models = append(fit.Models, ModelFit{
+		Model:       "O(n^k)",
+		Coefficient: math.Exp(intercept),
+		RSquared:    coefficientOfDetermination(logT, predicted),
+	})
+
+	return fit, true
+}
+
+// coefficientOfDetermination returns R² of predicted against observed values
+// When the observations are all equal, it is 1 for an exact prediction and 0 otherwise
+func coefficientOfDetermination(observed, predicted []float64) float64 {
+	observedMean := mean(observed)
+	residual, total := 0.0, 0.0
+
+	for i := range observed {
+		residual += (observed[i] - predicted[i]) * (observed[i] - predicted[i])
+		total += (observed[i] - observedMean) * (observed[i] - observedMean)
+	}
+
+	if total == 0 {
+		if residual == 0 {
+			return 1
+		}
+		return 0
+	}
+	return 1 - residual/total
+}
+
+// mean returns the arithmetic mean of values
+func mean(values []float64) float64 {
+	sum := 0.0
+	for _, value := range values {
+		sum += value
+	}
+	return sum / float64(len(values))
+}
+
+// variance returns the population variance of values
+func variance(values []float64) float64 {
+	m := mean(values)
+	sum := 0.0
+	for _, value := range values {
+		sum += (value - m) * (value - m)
+	}
+	return sum / float64(len(values))
+}
+
+// subtract returns a - b element by element
+func subtract(a, b []float64) []float64 {
+	result := make([]float64, len(a))
+	for i := range a {
+		result[i] = a[i] - b[i]
+	}
+	return result
+}
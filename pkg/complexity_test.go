@@ -0,0 +1,104 @@
+package pkg
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// TestParseComplexityClass tests the Big-O notations recognized by ParseComplexityClass
+func TestParseComplexityClass(t *testing.T) {
+	testCases := []struct {
+		notation string
+		expected ComplexityClass
+		wantErr  bool
+	}{
+		{notation: "O(n)", expected: Linear},
+		{notation: "O(n + k)", expected: Linear},
+		{notation: "O(d(n + b))", expected: Linear},
+		{notation: "O(n log n)", expected: Linearithmic},
+		{notation: "O(n²)", expected: Quadratic},
+		{notation: "O(n^2)", expected: Quadratic},
+		{notation: "O(n^(4/3))", expected: FourThirds},
+		{notation: "O(n^4/3)", expected: FourThirds},
+		{notation: "O(2^n)", wantErr: true},
+		{notation: "", wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.notation, func(t *testing.T) {
+			class, err := ParseComplexityClass(tc.notation)
+			if (err != nil) != tc.wantErr || (!tc.wantErr && class != tc.expected) {
+				t.Errorf("ParseComplexityClass(%q) = %v, %v; want %v", tc.notation, class, err, tc.expected)
+			}
+		})
+	}
+}
+
+// TestFitComplexity tests that synthetic timings are matched to the class that generated them
+func TestFitComplexity(t *testing.T) {
+	sizes := []int{500, 1000, 5000, 10000, 50000}
+
+	for _, class := range candidateClasses {
+		t.Run(class.String(), func(t *testing.T) {
+			results := make([]BenchmarkResult, len(sizes))
+			for i, n := range sizes {
+				results[i] = BenchmarkResult{Count: n, Duration: time.Duration(3 * class.Operations(n))}
+			}
+
+			fit, ok := FitComplexity(results)
+			if !ok {
+				t.Fatal("FitComplexity returned false for valid results")
+			}
+			if fit.Class != class {
+				t.Errorf("Class = %v; want %v", fit.Class, class)
+			}
+			if fit.RSquared < 0.999 {
+				t.Errorf("RSquared = %.4f; want close to 1", fit.RSquared)
+			}
+			if len(fit.Models) != len(candidateClasses)+1 {
+				t.Errorf("len(Models) = %d; want %d", len(fit.Models), len(candidateClasses)+1)
+			}
+		})
+	}
+}
+
+// TestFitComplexityExponent tests the exponent of the free power-law model
+func TestFitComplexityExponent(t *testing.T) {
+	var results []BenchmarkResult
+	for _, n := range []int{100, 1000, 10000} {
+		results = append(results, BenchmarkResult{Count: n, Duration: time.Duration(math.Pow(float64(n), 1.5))})
+	}
+
+	fit, ok := FitComplexity(results)
+	if !ok {
+		t.Fatal("FitComplexity returned false for valid results")
+	}
+	if math.Abs(fit.Exponent-1.5) > 0.01 {
+		t.Errorf("Exponent = %.3f; want 1.5", fit.Exponent)
+	}
+}
+
+// TestFitComplexityInsufficientData tests that fitting needs at least three distinct sizes
+func TestFitComplexityInsufficientData(t *testing.T) {
+	testCases := map[string][]BenchmarkResult{
+		"Empty":          nil,
+		"Single result":  {{Count: 1000, Duration: time.Millisecond}},
+		"Same size":      {{Count: 1000, Duration: time.Millisecond}, {Count: 1000, Duration: 2 * time.Millisecond}},
+		"Zero durations": {{Count: 1000}, {Count: 2000}, {Count: 4000}},
+		"Two sizes":      {{Count: 1000, Duration: time.Millisecond}, {Count: 2000, Duration: 2 * time.Millisecond}},
+		"Two distinct sizes": {
+			{Count: 1000, Duration: time.Millisecond},
+			{Count: 1000, Duration: time.Millisecond},
+			{Count: 2000, Duration: 2 * time.Millisecond},
+		},
+	}
+
+	for name, results := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if _, ok := FitComplexity(results); ok {
+				t.Error("FitComplexity returned true; want false")
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package pkg
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Distribution identifies the shape of a generated input
+type Distribution int
+
+const (
+	Uniform             Distribution = iota // uniform random values between 1 and 1000
+	Sorted                                  // ascending values
+	ReverseSorted                           // descending values
+	NearlySorted                            // ascending values with 1% of random swaps
+	FewUnique                               // only 10 distinct values
+	OrganPipe                               // rising to the middle and falling back
+	Sawtooth                                // five ascending runs
+	Gaussian                                // normal distribution around 500
+	Zipf                                    // few values very frequent, most values rare
+	AllEqual                                // a single repeated value
+	MedianOfThreeKiller                     // adversarial input for median-of-three quick sort
+)
+
+// distributionInfo holds the identifier and display name of each Distribution, in declaration order
+var distributionInfo = []struct {
+	id   string
+	name string
+}{
+	{"uniform", "Uniform random"},
+	{"sorted", "Sorted"},
+	{"reverse", "Reverse sorted"},
+	{"nearly-sorted", "Nearly sorted (1% swaps)"},
+	{"few-unique", "Few unique (10 values)"},
+	{"organ-pipe", "Organ pipe"},
+	{"sawtooth", "Sawtooth (5 runs)"},
+	{"gaussian", "Gaussian"},
+	{"zipf", "Zipf"},
+	{"all-equal", "All equal"},
+	{"median3-killer", "Median-of-3 killer"},
+}
+
+// Distributions returns every distribution, in menu order
+func Distributions() []Distribution {
+	distributions := make([]Distribution, len(distributionInfo))
+	for i := range distributions {
+		distributions[i] = Distribution(i)
+	}
+	return distributions
+}
+
+// String returns the short identifier of the distribution, e.g. "nearly-sorted"
+func (d Distribution) String() string {
+	if d < 0 || int(d) >= len(distributionInfo) {
+		return fmt.Sprintf("distribution(%d)", int(d))
+	}
+	return distributionInfo[d].id
+}
+
+// Name returns the display name of the distribution, e.g. "Nearly sorted (1% swaps)"
+func (d Distribution) Name() string {
+	if d < 0 || int(d) >= len(distributionInfo) {
+		return d.String()
+	}
+	return distributionInfo[d].name
+}
+
+// MarshalText encodes the distribution as its identifier
+func (d Distribution) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText decodes an identifier produced by MarshalText
+func (d *Distribution) UnmarshalText(text []byte) error {
+	distribution, err := ParseDistribution(string(text))
+	if err != nil {
+		return err
+	}
+	*d = distribution
+	return nil
+}
+
+// ParseDistribution maps an identifier such as "few-unique" to its Distribution
+func ParseDistribution(id string) (Distribution, error) {
+	normalized := strings.ToLower(strings.TrimSpace(id))
+	for i, info := range distributionInfo {
+		if info.id == normalized {
+			return Distribution(i), nil
+		}
+	}
+	return Uniform, fmt.Errorf("unknown input distribution %q", id)
+}
+
+// GenerateDistribution generates count integers shaped by the distribution
+// Value-based distributions use the default range 1-1000 like GenerateIntSliceDefault
+func (rg *RandomGenerator) GenerateDistribution(d Distribution, count int) []int {
+	switch d {
+	case Sorted:
+		return rg.GenerateSortedSlice(count, 1, 1000)
+	case ReverseSorted:
+		return rg.GenerateReverseSortedSlice(count, 1, 1000)
+	case NearlySorted:
+		return rg.GenerateNearlySortedSlice(count, 1, 1000, count/100+1)
+	case FewUnique:
+		return rg.GenerateFewUniqueSlice(count, 1, 1000, 10)
+	case OrganPipe:
+		return rg.GenerateOrganPipeSlice(count)
+	case Sawtooth:
+		return rg.GenerateSawtoothSlice(count, 5)
+	case Gaussian:
+		return rg.GenerateGaussianSlice(count, 1, 1000, 500, 150)
+	case Zipf:
+		return rg.GenerateZipfSlice(count, 1, 1000, 1.2)
+	case AllEqual:
+		return rg.GenerateAllEqualSlice(count, rg.rng.Intn(1000)+1)
+	case MedianOfThreeKiller:
+		return rg.GenerateMedianOfThreeKiller(count)
+	default:
+		return rg.GenerateIntSliceDefault(count)
+	}
+}
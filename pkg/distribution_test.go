@@ -0,0 +1,156 @@
+package pkg
+
+import (
+	"sort"
+	"testing"
+	"time"
+)
+
+// TestGenerateDistribution tests the length and shape of every distribution
+func TestGenerateDistribution(t *testing.T) {
+	generator := NewRandomGeneratorWithSeed(7)
+
+	for _, distribution := range Distributions() {
+		for _, count := range []int{0, 1, 2, 7, 1000} {
+			values := generator.GenerateDistribution(distribution, count)
+			if len(values) != count {
+				t.Errorf("%s: len = %d; want %d", distribution, len(values), count)
+			}
+		}
+	}
+
+	testCases := []struct {
+		distribution Distribution
+		check        func([]int) bool
+	}{
+		{Sorted, IsSortedSlice},
+		{ReverseSorted, IsSortedSliceDesc},
+		{AllEqual, func(values []int) bool { return distinctCount(values) == 1 }},
+		{FewUnique, func(values []int) bool { return distinctCount(values) <= 10 }},
+		{NearlySorted, func(values []int) bool { return !IsSortedSliceDesc(values) && inRange(values, 1, 1000) }},
+		{Gaussian, func(values []int) bool { return inRange(values, 1, 1000) }},
+		{Zipf, func(values []int) bool { return inRange(values, 1, 1000) && mostFrequent(values) == 1 }},
+		{OrganPipe, func(values []int) bool {
+			return IsSortedSlice(values[:len(values)/2]) && IsSortedSliceDesc(values[len(values)/2:])
+		}},
+		{Sawtooth, func(values []int) bool { return descents(values) == 4 }},
+		{MedianOfThreeKiller, isPermutation},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.distribution.String(), func(t *testing.T) {
+			values := generator.GenerateDistribution(tc.distribution, 1000)
+			if !tc.check(values) {
+				t.Errorf("%s input does not have the expected shape: %v", tc.distribution.Name(), values[:20])
+			}
+		})
+	}
+}
+
+// TestGenerateLargeSortedDistributions tests that the sorted distributions of a million elements
+// are generated quickly, as the terminal and the CLI allow inputs that large
+func TestGenerateLargeSortedDistributions(t *testing.T) {
+	generator := NewRandomGeneratorWithSeed(7)
+	const count = 1_000_000
+
+	testCases := []struct {
+		distribution Distribution
+		check        func([]int) bool
+	}{
+		{Sorted, IsSortedSlice},
+		{ReverseSorted, IsSortedSliceDesc},
+		{NearlySorted, func(values []int) bool { return inRange(values, 1, 1000) }},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.distribution.String(), func(t *testing.T) {
+			start := time.Now()
+			values := generator.GenerateDistribution(tc.distribution, count)
+			elapsed := time.Since(start)
+
+			if len(values) != count || !tc.check(values) {
+				t.Errorf("%s input of %d elements does not have the expected shape", tc.distribution.Name(), len(values))
+			}
+			// An O(n²) generator takes minutes here, an O(n log n) one well under a second
+			if elapsed > 5*time.Second {
+				t.Errorf("generating %d %s elements took %v; want under 5s", count, tc.distribution.Name(), elapsed)
+			}
+		})
+	}
+}
+
+// TestGenerateMedianOfThreeKillerLengths tests that the killer is a permutation of 1..n for every length
+func TestGenerateMedianOfThreeKillerLengths(t *testing.T) {
+	generator := NewRandomGenerator()
+
+	for count := 1; count <= 64; count++ {
+		if values := generator.GenerateMedianOfThreeKiller(count); !isPermutation(values) {
+			t.Errorf("GenerateMedianOfThreeKiller(%d) = %v; want a permutation of 1..%d", count, values, count)
+		}
+	}
+}
+
+// TestParseDistribution tests that every identifier parses back to its distribution
+func TestParseDistribution(t *testing.T) {
+	for _, distribution := range Distributions() {
+		parsed, err := ParseDistribution(distribution.String())
+		if err != nil || parsed != distribution {
+			t.Errorf("ParseDistribution(%q) = %v, %v; want %v", distribution.String(), parsed, err, distribution)
+		}
+	}
+
+	if _, err := ParseDistribution("bimodal"); err == nil {
+		t.Error("ParseDistribution(\"bimodal\") returned no error")
+	}
+}
+
+func distinctCount(values []int) int {
+	seen := make(map[int]bool)
+	for _, value := range values {
+		seen[value] = true
+	}
+	return len(seen)
+}
+
+func inRange(values []int, min, max int) bool {
+	for _, value := range values {
+		if value < min || value > max {
+			return false
+		}
+	}
+	return true
+}
+
+func mostFrequent(values []int) int {
+	counts := make(map[int]int)
+	best := values[0]
+	for _, value := range values {
+		counts[value]++
+		if counts[value] > counts[best] {
+			best = value
+		}
+	}
+	return best
+}
+
+func descents(values []int) int {
+	count := 0
+	for i := 1; i < len(values); i++ {
+		if values[i] < values[i-1] {
+			count++
+		}
+	}
+	return count
+}
+
+func isPermutation(values []int) bool {
+	sorted := make([]int, len(values))
+	copy(sorted, values)
+	sort.Ints(sorted)
+	for i, value := range sorted {
+		if value != i+1 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,195 @@
+package pkg
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ExportFormat identifies a machine-readable output format
+type ExportFormat string
+
+const (
+	ExportJSON     ExportFormat = "json"
+	ExportCSV      ExportFormat = "csv"
+	ExportMarkdown ExportFormat = "markdown"
+)
+
+// Environment describes the machine and seed a run was executed with
+type Environment struct {
+	GOOS      string `json:"goos"`
+	GOARCH    string `json:"goarch"`
+	GoVersion string `json:"go_version"`
+	NumCPU    int    `json:"num_cpu"`
+	MaxProcs  int    `json:"gomaxprocs"` // CPUs the Go scheduler may use at once, which bounds parallel speedups
+	Seed      int64  `json:"seed"`       // seed of the random input, 0 when the input was not generated
+}
+
+// EnvironmentCSVHeader lists the CSV columns written by Environment.CSVRecord
+var EnvironmentCSVHeader = []string{"goos", "goarch", "go_version", "num_cpu", "gomaxprocs", "seed"}
+
+// CaptureEnvironment returns the environment of the current process with the given seed
+func CaptureEnvironment(seed int64) Environment {
+	return Environment{
+		GOOS:      runtime.GOOS,
+		GOARCH:    runtime.GOARCH,
+		GoVersion: runtime.Version(),
+		NumCPU:    runtime.NumCPU(),
+		MaxProcs:  runtime.GOMAXPROCS(0),
+		Seed:      seed,
+	}
+}
+
+// String returns a one-line description such as "linux/amd64, go1.24.3, 8 CPUs, GOMAXPROCS 8, seed 42"
+func (e Environment) String() string {
+	return fmt.Sprintf("%s/%s, %s, %d CPUs, GOMAXPROCS %d, seed %d", e.GOOS, e.GOARCH, e.GoVersion, e.NumCPU, e.MaxProcs, e.Seed)
+}
+
+// CSVRecord returns the environment as CSV fields in EnvironmentCSVHeader order
+func (e Environment) CSVRecord() []string {
+	return []string{e.GOOS, e.GOARCH, e.GoVersion, strconv.Itoa(e.NumCPU), strconv.Itoa(e.MaxProcs), strconv.FormatInt(e.Seed, 10)}
+}
+
+// ParseExportFormat maps a format name to an ExportFormat, accepting "md" for Markdown
+func ParseExportFormat(name string) (ExportFormat, error) {
+	switch strings.ToLower(name) {
+	case "json":
+		return ExportJSON, nil
+	case "csv":
+		return ExportCSV, nil
+	case "markdown", "md":
+		return ExportMarkdown, nil
+	default:
+		return "", fmt.Errorf("unknown export format %q", name)
+	}
+}
+
+// WriteJSON writes value as indented JSON
+func WriteJSON(w io.Writer, value any) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(value)
+}
+
+// ExportBenchmarks writes benchmark summaries in the given format
+// JSON is an array of summaries, CSV has one row per result and Markdown one table per summary
+func ExportBenchmarks(w io.Writer, format ExportFormat, summaries []BenchmarkSummary) error {
+	switch format {
+	case ExportJSON:
+		return WriteJSON(w, summaries)
+	case ExportCSV:
+		return writeBenchmarksCSV(w, summaries)
+	case ExportMarkdown:
+		return writeBenchmarksMarkdown(w, summaries)
+	default:
+		return fmt.Errorf("unknown export format %q", format)
+	}
+}
+
+func writeBenchmarksCSV(w io.Writer, summaries []BenchmarkSummary) error {
+	writer := csv.NewWriter(w)
+
+	header := []string{"algorithm", "class", "distribution", "count", "duration_ns", "sorted",
+		"runs", "min_ns", "median_ns", "mean_ns", "stddev_ns", "p95_ns", "ci_low_ns", "ci_high_ns", "baseline", "speedup"}
+	header = append(header, EnvironmentCSVHeader...)
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	for _, summary := range summaries {
+		for _, result := range summary.Results {
+			record := []string{
+				summary.Algorithm,
+				summary.Class.String(),
+				summary.Distribution.String(),
+				strconv.Itoa(result.Count),
+				strconv.FormatInt(result.Duration.Nanoseconds(), 10),
+				strconv.FormatBool(result.IsSorted),
+				strconv.Itoa(result.Stats.Runs),
+				formatNanoseconds(result.Stats.Min),
+				formatNanoseconds(result.Stats.Median),
+				formatNanoseconds(result.Stats.Mean),
+				formatNanoseconds(result.Stats.StdDev),
+				formatNanoseconds(result.Stats.P95),
+				formatNanoseconds(result.Stats.CILow),
+				formatNanoseconds(result.Stats.CIHigh),
+				summary.Baseline,
+				formatSpeedup(result.Speedup),
+			}
+			if err := writer.Write(append(record, summary.Environment.CSVRecord()...)); err != nil {
+				return err
+			}
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func writeBenchmarksMarkdown(w io.Writer, summaries []BenchmarkSummary) error {
+	var b strings.Builder
+
+	for i, summary := range summaries {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+
+		fmt.Fprintf(&b, "### %s (%s)\n\n", summary.Algorithm, summary.Class)
+		fmt.Fprintf(&b, "Environment: %s\n\n", summary.Environment)
+		fmt.Fprintf(&b, "Input: %s, %d runs per size after %d warm-up\n\n", summary.Distribution.Name(), summary.Runs, summary.WarmupRuns)
+		b.WriteString("| Count | Median | Mean ± σ | P95 | 95% CI | μs/number | Sorted |\n")
+		b.WriteString("|------:|-------:|---------:|----:|:------:|----------:|:------:|\n")
+
+		for _, result := range summary.Results {
+			timePerNumber := float64(result.Duration.Nanoseconds()) / float64(result.Count) / 1000.0
+			fmt.Fprintf(&b, "| %s | %v | %v ± %v | %v | %v – %v | %.2f | %t |\n",
+				FormatNumber(result.Count),
+				result.Duration,
+				result.Stats.Mean,
+				result.Stats.StdDev,
+				result.Stats.P95,
+				result.Stats.CILow,
+				result.Stats.CIHigh,
+				timePerNumber,
+				result.IsSorted)
+		}
+
+		if summary.Baseline != "" {
+			speedups := make([]string, len(summary.Results))
+			for i, result := range summary.Results {
+				speedups[i] = fmt.Sprintf("%s → %.2fx", FormatNumber(result.Count), result.Speedup)
+			}
+			fmt.Fprintf(&b, "\nSpeedup vs %s: %s\n", summary.Baseline, strings.Join(speedups, ", "))
+		}
+
+		if summary.Fit != nil {
+			fmt.Fprintf(&b, "\nBest fit: %s (R² = %.4f), empirical exponent n^%.2f\n",
+				summary.Fit.Class,
+				summary.Fit.RSquared,
+				summary.Fit.Exponent)
+		} else {
+			b.WriteString("\nBest fit: not enough data to fit, it needs at least three distinct sizes\n")
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// formatSpeedup writes a speedup with 4 decimals, or an empty field when there was no baseline
+func formatSpeedup(speedup float64) string {
+	if speedup == 0 {
+		return ""
+	}
+	return strconv.FormatFloat(speedup, 'f', 4, 64)
+}
+
+// formatNanoseconds writes a duration as an integer number of nanoseconds
+func formatNanoseconds(d time.Duration) string {
+	return strconv.FormatInt(d.Nanoseconds(), 10)
+}
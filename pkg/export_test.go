@@ -0,0 +1,171 @@
+package pkg
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// sampleSummaries returns a benchmark summary with three results and a fit
+func sampleSummaries() []BenchmarkSummary {
+	stats := []DurationStats{
+		CalculateDurationStats([]time.Duration{time.Millisecond, 2 * time.Millisecond, 3 * time.Millisecond}),
+		CalculateDurationStats([]time.Duration{7 * time.Millisecond, 8 * time.Millisecond, 9 * time.Millisecond}),
+		CalculateDurationStats([]time.Duration{31 * time.Millisecond, 32 * time.Millisecond, 33 * time.Millisecond}),
+	}
+	results := []BenchmarkResult{
+		{Count: 1000, Duration: stats[0].Median, IsSorted: true, Stats: stats[0]},
+		{Count: 2000, Duration: stats[1].Median, IsSorted: true, Stats: stats[1]},
+		{Count: 4000, Duration: stats[2].Median, IsSorted: true, Stats: stats[2]},
+	}
+	fit, _ := FitComplexity(results)
+
+	return []BenchmarkSummary{{
+		Algorithm:    "bubble",
+		Class:        Quadratic,
+		Environment:  Environment{GOOS: "linux", GOARCH: "amd64", GoVersion: "go1.24.3", NumCPU: 8, MaxProcs: 4, Seed: 42},
+		Distribution: FewUnique,
+		Runs:         3,
+		WarmupRuns:   1,
+		Results:      results,
+		Scaling:      CalculateScaling(results),
+		Fit:          &fit,
+	}}
+}
+
+// TestExportBenchmarksJSON tests that exported JSON decodes back to the same summaries
+func TestExportBenchmarksJSON(t *testing.T) {
+	summaries := sampleSummaries()
+
+	var buffer bytes.Buffer
+	if err := ExportBenchmarks(&buffer, ExportJSON, summaries); err != nil {
+		t.Fatalf("ExportBenchmarks returned unexpected error: %v", err)
+	}
+
+	if !strings.Contains(buffer.String(), `"class": "O(n²)"`) {
+		t.Errorf("JSON does not encode the class as Big-O notation:\n%s", buffer.String())
+	}
+
+	var decoded []BenchmarkSummary
+	if err := json.Unmarshal(buffer.Bytes(), &decoded); err != nil {
+		t.Fatalf("JSON does not decode: %v", err)
+	}
+	if !reflect.DeepEqual(decoded, summaries) {
+		t.Errorf("decoded = %+v; want %+v", decoded, summaries)
+	}
+}
+
+// TestExportBenchmarksCSV tests that CSV has a header and one row per result with the environment
+func TestExportBenchmarksCSV(t *testing.T) {
+	summaries := sampleSummaries()
+
+	var buffer bytes.Buffer
+	if err := ExportBenchmarks(&buffer, ExportCSV, summaries); err != nil {
+		t.Fatalf("ExportBenchmarks returned unexpected error: %v", err)
+	}
+
+	records, err := csv.NewReader(&buffer).ReadAll()
+	if err != nil {
+		t.Fatalf("CSV does not parse: %v", err)
+	}
+
+	expected := [][]string{{"algorithm", "class", "distribution", "count", "duration_ns", "sorted",
+		"runs", "min_ns", "median_ns", "mean_ns", "stddev_ns", "p95_ns", "ci_low_ns", "ci_high_ns",
+		"baseline", "speedup", "goos", "goarch", "go_version", "num_cpu", "gomaxprocs", "seed"}}
+	for _, result := range summaries[0].Results {
+		stats := result.Stats
+		record := []string{"bubble", "O(n²)", "few-unique", strconv.Itoa(result.Count), formatNanoseconds(result.Duration), "true", "3"}
+		for _, d := range []time.Duration{stats.Min, stats.Median, stats.Mean, stats.StdDev, stats.P95, stats.CILow, stats.CIHigh} {
+			record = append(record, formatNanoseconds(d))
+		}
+		expected = append(expected, append(record, "", "", "linux", "amd64", "go1.24.3", "8", "4", "42"))
+	}
+	if !reflect.DeepEqual(records, expected) {
+		t.Errorf("records = %v; want %v", records, expected)
+	}
+}
+
+// TestExportBenchmarksMarkdown tests that Markdown contains the environment, a table row per result and the fit
+func TestExportBenchmarksMarkdown(t *testing.T) {
+	var buffer bytes.Buffer
+	if err := ExportBenchmarks(&buffer, ExportMarkdown, sampleSummaries()); err != nil {
+		t.Fatalf("ExportBenchmarks returned unexpected error: %v", err)
+	}
+
+	output := buffer.String()
+	for _, want := range []string{
+		"### bubble (O(n²))",
+		"Environment: linux/amd64, go1.24.3, 8 CPUs, GOMAXPROCS 4, seed 42",
+		"Input: Few unique (10 values), 3 runs per size after 1 warm-up",
+		"| 1,000 | 2ms | 2ms ± 1ms | 2.9ms | 0s – 4.484338ms | 2.00 | true |",
+		"Best fit: O(n²)",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Markdown is missing %q:\n%s", want, output)
+		}
+	}
+}
+
+// TestExportBenchmarksMarkdownWithoutFit tests that Markdown says when there was not enough data to fit
+func TestExportBenchmarksMarkdownWithoutFit(t *testing.T) {
+	summaries := sampleSummaries()
+	summaries[0].Results = summaries[0].Results[:2]
+	summaries[0].Fit = nil
+
+	var buffer bytes.Buffer
+	if err := ExportBenchmarks(&buffer, ExportMarkdown, summaries); err != nil {
+		t.Fatalf("ExportBenchmarks returned unexpected error: %v", err)
+	}
+	if want := "Best fit: not enough data to fit"; !strings.Contains(buffer.String(), want) {
+		t.Errorf("Markdown is missing %q:\n%s", want, buffer.String())
+	}
+}
+
+// TestExportBenchmarksSpeedup tests that parallel summaries export their baseline and speedups
+func TestExportBenchmarksSpeedup(t *testing.T) {
+	summaries := sampleSummaries()
+	summaries[0].Baseline = "insertion"
+	summaries[0].Results[0].Speedup = 1.5
+	summaries[0].Results[1].Speedup = 3.25
+
+	var buffer bytes.Buffer
+	if err := ExportBenchmarks(&buffer, ExportCSV, summaries); err != nil {
+		t.Fatalf("ExportBenchmarks returned unexpected error: %v", err)
+	}
+
+	records, err := csv.NewReader(&buffer).ReadAll()
+	if err != nil {
+		t.Fatalf("CSV does not parse: %v", err)
+	}
+	for i, want := range []string{"1.5000", "3.2500"} {
+		if got := records[i+1][14:16]; !reflect.DeepEqual(got, []string{"insertion", want}) {
+			t.Errorf("row %d baseline and speedup = %v; want [insertion %s]", i+1, got, want)
+		}
+	}
+
+	buffer.Reset()
+	if err := ExportBenchmarks(&buffer, ExportMarkdown, summaries); err != nil {
+		t.Fatalf("ExportBenchmarks returned unexpected error: %v", err)
+	}
+	if want := "Speedup vs insertion: 1,000 → 1.50x, 2,000 → 3.25x"; !strings.Contains(buffer.String(), want) {
+		t.Errorf("Markdown is missing %q:\n%s", want, buffer.String())
+	}
+}
+
+// TestParseExportFormat tests format names and aliases
+func TestParseExportFormat(t *testing.T) {
+	for name, expected := range map[string]ExportFormat{"json": ExportJSON, "CSV": ExportCSV, "md": ExportMarkdown, "markdown": ExportMarkdown} {
+		if format, err := ParseExportFormat(name); err != nil || format != expected {
+			t.Errorf("ParseExportFormat(%q) = %q, %v; want %q", name, format, err, expected)
+		}
+	}
+
+	if _, err := ParseExportFormat("xml"); err == nil {
+		t.Error("ParseExportFormat(\"xml\") returned no error")
+	}
+}
@@ -1,29 +1,36 @@
 package pkg
 
 import (
+	"math"
 	"math/rand"
+	"slices"
 	"time"
 )
 
 // RandomGenerator provides utilities for generating random data
 type RandomGenerator struct {
-	rng *rand.Rand
+	rng  *rand.Rand
+	seed int64
 }
 
 // NewRandomGenerator creates a new RandomGenerator with current time seed
 func NewRandomGenerator() *RandomGenerator {
-	return &RandomGenerator{
-		rng: rand.New(rand.NewSource(time.Now().UnixNano())),
-	}
+	return NewRandomGeneratorWithSeed(time.Now().UnixNano())
 }
 
 // NewRandomGeneratorWithSeed creates a new RandomGenerator with specific seed
 func NewRandomGeneratorWithSeed(seed int64) *RandomGenerator {
 	return &RandomGenerator{
-		rng: rand.New(rand.NewSource(seed)),
+		rng:  rand.New(rand.NewSource(seed)),
+		seed: seed,
 	}
 }
 
+// Seed returns the seed the generator was created with, so a run can be reproduced
+func (rg *RandomGenerator) Seed() int64 {
+	return rg.seed
+}
+
 // GenerateIntSlice generates a slice of random integers
 func (rg *RandomGenerator) GenerateIntSlice(count, min, max int) []int {
 	if count <= 0 {
@@ -52,16 +59,8 @@ func (rg *RandomGenerator) GenerateIntSliceDefault(count int) []int {
 // GenerateSortedSlice generates a sorted slice of integers
 func (rg *RandomGenerator) GenerateSortedSlice(count, min, max int) []int {
 	slice := rg.GenerateIntSlice(count, min, max)
-	// Simple insertion sort for small arrays
-	for i := 1; i < len(slice); i++ {
-		key := slice[i]
-		j := i - 1
-		for j >= 0 && slice[j] > key {
-			slice[j+1] = slice[j]
-			j--
-		}
-		slice[j+1] = key
-	}
+	// The sorted distributions go up to a million elements, so this must be O(n log n)
+	slices.Sort(slice)
 	return slice
 }
 
@@ -82,3 +81,151 @@ func (rg *RandomGenerator) ShuffleSlice(slice []int) {
 		slice[i], slice[j] = slice[j], slice[i]
 	}
 }
+
+// GenerateNearlySortedSlice generates a sorted slice and then swaps swaps random pairs of elements
+func (rg *RandomGenerator) GenerateNearlySortedSlice(count, min, max, swaps int) []int {
+	slice := rg.GenerateSortedSlice(count, min, max)
+	if len(slice) < 2 {
+		return slice
+	}
+
+	for k := 0; k < swaps; k++ {
+		i, j := rg.rng.Intn(len(slice)), rg.rng.Intn(len(slice))
+		slice[i], slice[j] = slice[j], slice[i]
+	}
+	return slice
+}
+
+// GenerateFewUniqueSlice generates a slice drawing from only unique distinct values between min and max
+func (rg *RandomGenerator) GenerateFewUniqueSlice(count, min, max, unique int) []int {
+	if count <= 0 {
+		return []int{}
+	}
+	if unique < 1 {
+		unique = 1
+	}
+
+	values := rg.GenerateIntSlice(unique, min, max)
+	result := make([]int, count)
+	for i := range result {
+		result[i] = values[rg.rng.Intn(unique)]
+	}
+	return result
+}
+
+// GenerateAllEqualSlice generates a slice where every element is value
+func (rg *RandomGenerator) GenerateAllEqualSlice(count, value int) []int {
+	if count <= 0 {
+		return []int{}
+	}
+
+	result := make([]int, count)
+	for i := range result {
+		result[i] = value
+	}
+	return result
+}
+
+// GenerateOrganPipeSlice generates values rising to the middle and falling back: 0, 1, 2, ..., 2, 1, 0
+func (rg *RandomGenerator) GenerateOrganPipeSlice(count int) []int {
+	if count <= 0 {
+		return []int{}
+	}
+
+	result := make([]int, count)
+	for i := range result {
+		if i < count/2 {
+			result[i] = i
+		} else {
+			result[i] = count - 1 - i
+		}
+	}
+	return result
+}
+
+// GenerateSawtoothSlice generates teeth ascending runs of equal length: 0, 1, ..., 0, 1, ...
+func (rg *RandomGenerator) GenerateSawtoothSlice(count, teeth int) []int {
+	if count <= 0 {
+		return []int{}
+	}
+	if teeth < 1 {
+		teeth = 1
+	}
+
+	toothLength := (count + teeth - 1) / teeth
+	result := make([]int, count)
+	for i := range result {
+		result[i] = i % toothLength
+	}
+	return result
+}
+
+// GenerateGaussianSlice generates normally distributed integers clamped to [min, max]
+func (rg *RandomGenerator) GenerateGaussianSlice(count, min, max int, mean, stdDev float64) []int {
+	if count <= 0 {
+		return []int{}
+	}
+	if min > max {
+		min, max = max, min
+	}
+
+	result := make([]int, count)
+	for i := range result {
+		value := int(math.Round(rg.rng.NormFloat64()*stdDev + mean))
+		result[i] = clamp(value, min, max)
+	}
+	return result
+}
+
+// GenerateZipfSlice generates integers in [min, max] following Zipf's law with exponent s > 1
+// min is the most frequent value and each following value is rarer
+func (rg *RandomGenerator) GenerateZipfSlice(count, min, max int, s float64) []int {
+	if count <= 0 {
+		return []int{}
+	}
+	if min > max {
+		min, max = max, min
+	}
+
+	zipf := rand.NewZipf(rg.rng, s, 1, uint64(max-min))
+	result := make([]int, count)
+	for i := range result {
+		result[i] = min + int(zipf.Uint64())
+	}
+	return result
+}
+
+// GenerateMedianOfThreeKiller generates Musser's adversarial permutation of 1..count
+// It drives a quick sort that takes the median of the first, middle and last elements to O(n²)
+func (rg *RandomGenerator) GenerateMedianOfThreeKiller(count int) []int {
+	if count <= 0 {
+		return []int{}
+	}
+
+	// The construction needs a length divisible by four, the remaining largest values are appended in order
+	length := count - count%4
+	k := length / 2
+	result := make([]int, count)
+	for i := 1; i <= k; i++ {
+		if i%2 == 1 {
+			result[i-1] = i
+			result[i] = k + i
+		}
+		result[k+i-1] = 2 * i
+	}
+	for i := length; i < count; i++ {
+		result[i] = i + 1
+	}
+	return result
+}
+
+// clamp limits value to [min, max]
+func clamp(value, min, max int) int {
+	if value < min {
+		return min
+	}
+	if value > max {
+		return max
+	}
+	return value
+}
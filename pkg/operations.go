@@ -0,0 +1,79 @@
+package pkg
+
+// OperationCounter records the work actually performed by an instrumented sort
+// A nil *OperationCounter is valid and records nothing, so algorithms can call its
+// methods unconditionally and pay only a nil check when instrumentation is off
+type OperationCounter struct {
+	Comparisons       int64 `json:"comparisons"`        // calls to the comparator
+	Swaps             int64 `json:"swaps"`              // element exchanges
+	Writes            int64 `json:"writes"`             // element stores, including the two stores of every swap
+	MaxDepth          int   `json:"max_depth"`          // deepest recursion level reached (0 for iterative sorts)
+	Allocations       int64 `json:"allocations"`        // auxiliary allocations (buffers, nodes) made by the sort
+	AllocatedElements int64 `json:"allocated_elements"` // total elements held by those allocations
+
+	depth int
+}
+
+// NewOperationCounter creates a new zeroed OperationCounter
+func NewOperationCounter() *OperationCounter {
+	return &OperationCounter{}
+}
+
+// Compare records a single comparison
+func (c *OperationCounter) Compare() {
+	if c != nil {
+		c.Comparisons++
+	}
+}
+
+// Swap records an exchange of two elements, which is two element writes
+func (c *OperationCounter) Swap() {
+	if c != nil {
+		c.Swaps++
+		c.Writes += 2
+	}
+}
+
+// Write records n element writes that are not part of a swap
+func (c *OperationCounter) Write(n int) {
+	if c != nil {
+		c.Writes += int64(n)
+	}
+}
+
+// Enter records entering a recursive call and updates the maximum depth
+func (c *OperationCounter) Enter() {
+	if c != nil {
+		c.depth++
+		if c.depth > c.MaxDepth {
+			c.MaxDepth = c.depth
+		}
+	}
+}
+
+// Exit records returning from a recursive call
+func (c *OperationCounter) Exit() {
+	if c != nil {
+		c.depth--
+	}
+}
+
+// Allocate records an auxiliary allocation holding the given number of elements
+func (c *OperationCounter) Allocate(elements int) {
+	if c != nil {
+		c.Allocations++
+		c.AllocatedElements += int64(elements)
+	}
+}
+
+// CountComparisons wraps a comparator so that every call is recorded in the counter
+// When the counter is nil the comparator is returned unchanged
+func CountComparisons[T any](c *OperationCounter, compare func(a, b T) int) func(a, b T) int {
+	if c == nil {
+		return compare
+	}
+	return func(a, b T) int {
+		c.Comparisons++
+		return compare(a, b)
+	}
+}
@@ -0,0 +1,65 @@
+package pkg
+
+import (
+	"runtime"
+	"sync"
+)
+
+// DefaultParallelThreshold is the smallest range a parallel sort hands to another goroutine by default
+// Below it the cost of a goroutine outweighs the work it saves
+const DefaultParallelThreshold = 4096
+
+// ParallelOptions controls how a parallel sort splits its work across goroutines
+type ParallelOptions struct {
+	Threshold int // smallest range forked to another goroutine, smaller ranges are sorted sequentially
+	Workers   int // maximum goroutines sorting at the same time, including the caller
+}
+
+// DefaultParallelOptions uses DefaultParallelThreshold and one worker per GOMAXPROCS
+func DefaultParallelOptions() ParallelOptions {
+	return ParallelOptions{
+		Threshold: DefaultParallelThreshold,
+		Workers:   runtime.GOMAXPROCS(0),
+	}
+}
+
+// Normalize replaces unset or invalid fields with their defaults
+func (o ParallelOptions) Normalize() ParallelOptions {
+	defaults := DefaultParallelOptions()
+	if o.Threshold < 1 {
+		o.Threshold = defaults.Threshold
+	}
+	if o.Workers < 1 {
+		o.Workers = defaults.Workers
+	}
+	return o
+}
+
+// WorkerLimiter bounds the goroutines forked by a parallel sort
+// Go never blocks: when every worker is busy the task runs on the calling goroutine instead,
+// so a recursive sort can fork freely without deadlocking on its own children
+type WorkerLimiter struct {
+	tokens chan struct{}
+}
+
+// NewWorkerLimiter creates a limiter for workers goroutines, counting the caller as one of them
+func NewWorkerLimiter(workers int) *WorkerLimiter {
+	return &WorkerLimiter{tokens: make(chan struct{}, max(workers-1, 0))}
+}
+
+// Go runs task on a new goroutine tracked by wg when a worker is free, or inline otherwise
+func (l *WorkerLimiter) Go(wg *sync.WaitGroup, task func()) {
+	select {
+	case l.tokens <- struct{}{}:
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-l.tokens
+				wg.Done()
+			}()
+			task()
+		}()
+	default:
+		task()
+	}
+}
@@ -0,0 +1,76 @@
+package pkg
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// TestParallelOptionsNormalize tests that unset fields fall back to their defaults
+func TestParallelOptionsNormalize(t *testing.T) {
+	tests := []struct {
+		name     string
+		options  ParallelOptions
+		expected ParallelOptions
+	}{
+		{"Zero value", ParallelOptions{}, ParallelOptions{Threshold: DefaultParallelThreshold, Workers: runtime.GOMAXPROCS(0)}},
+		{"Negative fields", ParallelOptions{Threshold: -1, Workers: -4}, ParallelOptions{Threshold: DefaultParallelThreshold, Workers: runtime.GOMAXPROCS(0)}},
+		{"Explicit fields", ParallelOptions{Threshold: 64, Workers: 3}, ParallelOptions{Threshold: 64, Workers: 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.options.Normalize(); got != tt.expected {
+				t.Errorf("Normalize() = %+v; want %+v", got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestWorkerLimiter checks that no more than the allowed goroutines run at once and every task runs
+func TestWorkerLimiter(t *testing.T) {
+	for _, workers := range []int{1, 2, 4} {
+		limiter := NewWorkerLimiter(workers)
+
+		var wg sync.WaitGroup
+		var running, peak, done atomic.Int64
+		release := make(chan struct{})
+
+		// The first tasks block until released, so the limiter must run the rest inline
+		// once every worker is busy, including the calling goroutine
+		for i := 0; i < workers-1; i++ {
+			limiter.Go(&wg, func() {
+				current := running.Add(1)
+				for {
+					previous := peak.Load()
+					if current <= previous || peak.CompareAndSwap(previous, current) {
+						break
+					}
+				}
+				<-release
+				running.Add(-1)
+				done.Add(1)
+			})
+		}
+
+		inline := false
+		limiter.Go(&wg, func() {
+			inline = true
+			done.Add(1)
+		})
+		if !inline {
+			t.Errorf("workers=%d: task did not run inline while every worker was busy", workers)
+		}
+
+		close(release)
+		wg.Wait()
+
+		if got := peak.Load(); got > int64(workers-1) {
+			t.Errorf("workers=%d: %d forked goroutines ran at once; want at most %d", workers, got, workers-1)
+		}
+		if got := done.Load(); got != int64(workers) {
+			t.Errorf("workers=%d: %d tasks finished; want %d", workers, got, workers)
+		}
+	}
+}
@@ -2,46 +2,62 @@ package pkg
 
 import (
 	"fmt"
-	"math"
 	"strings"
 	"time"
 )
 
 // PerformanceAnalysis contains performance metrics
 type PerformanceAnalysis struct {
-	TimePerNumber       float64 // microseconds per number
-	TheoreticalOps      float64 // O(n log n) operations
-	TimePerOperation    float64 // nanoseconds per operation
+	Class               ComplexityClass `json:"class"`              // complexity class the theoretical operations are based on
+	TimePerNumber       float64         `json:"time_per_number_us"` // microseconds per number
+	TheoreticalOps      float64         `json:"theoretical_ops"`    // operations predicted by Class
+	TimePerOperation    float64         `json:"time_per_op_ns"`     // nanoseconds per operation
 }
 
 // BenchmarkResult stores individual benchmark results
+// With repeated runs, Duration is the median and Stats describes the spread of every run
 type BenchmarkResult struct {
-	Count    int
-	Duration time.Duration
-	IsSorted bool
+	Count    int           `json:"count"`
+	Duration time.Duration `json:"duration_ns"`
+	IsSorted bool          `json:"sorted"`
+	Stats    DurationStats `json:"stats"`
+	Speedup  float64       `json:"speedup,omitempty"` // baseline median divided by this median, 0 without a baseline
 }
 
 // ScalingAnalysis compares performance between different input sizes
 type ScalingAnalysis struct {
-	FromSize   int
-	ToSize     int
-	SizeRatio  float64
-	TimeRatio  float64
+	FromSize   int     `json:"from_size"`
+	ToSize     int     `json:"to_size"`
+	SizeRatio  float64 `json:"size_ratio"`
+	TimeRatio  float64 `json:"time_ratio"`
 }
 
 // BenchmarkSummary contains results from multiple benchmarks
 type BenchmarkSummary struct {
-	Results []BenchmarkResult
-	Scaling []ScalingAnalysis
+	Algorithm    string            `json:"algorithm"`    // ID of the benchmarked algorithm
+	Class        ComplexityClass   `json:"class"`        // declared average complexity of the algorithm
+	Environment  Environment       `json:"environment"`  // machine and seed the benchmark ran with
+	Distribution Distribution      `json:"distribution"` // shape of the generated inputs
+	Runs         int               `json:"runs"`         // timed runs per size
+	WarmupRuns   int               `json:"warmup_runs"`  // discarded runs per size before the timed ones
+	Results      []BenchmarkResult `json:"results"`
+	Scaling      []ScalingAnalysis `json:"scaling"`
+	Fit          *ComplexityFit    `json:"fit,omitempty"` // best-fit model of the results, nil when there are too few points
+	Baseline     string            `json:"baseline,omitempty"` // ID of the sequential algorithm the speedups are measured against
 }
 
-// CalculateAnalysis provides performance analysis based on theoretical complexity
-func CalculateAnalysis(count int, duration time.Duration) PerformanceAnalysis {
+// CalculateAnalysis provides performance analysis based on the given complexity class
+func CalculateAnalysis(count int, duration time.Duration, class ComplexityClass) PerformanceAnalysis {
+	if count <= 0 {
+		return PerformanceAnalysis{Class: class}
+	}
+
 	timePerNumber := float64(duration.Nanoseconds()) / float64(count) / 1000.0 // microseconds
-	theoreticalOps := float64(count) * math.Log2(float64(count))
+	theoreticalOps := class.Operations(count)
 	timePerOperation := float64(duration.Nanoseconds()) / theoreticalOps
 
 	return PerformanceAnalysis{
+		Class:            class,
 		TimePerNumber:    timePerNumber,
 		TheoreticalOps:   theoreticalOps,
 		TimePerOperation: timePerOperation,
@@ -82,7 +98,8 @@ func PrintPerformanceInfo(count int, duration time.Duration, analysis Performanc
 }
 
 // PrintDetailedPerformance prints detailed performance analysis
-func PrintDetailedPerformance(count int, duration time.Duration, analysis PerformanceAnalysis, isSorted bool) {
+// operations may be nil when the run was not instrumented
+func PrintDetailedPerformance(count int, duration time.Duration, analysis PerformanceAnalysis, isSorted bool, operations *OperationCounter) {
 	PrintPerformanceInfo(count, duration, analysis)
 	
 	if isSorted {
@@ -91,18 +108,34 @@ func PrintDetailedPerformance(count int, duration time.Duration, analysis Perfor
 		fmt.Println("❌ Warning: List may not be correctly sorted!")
 	}
 
-	fmt.Printf("📈 Theoretical O(n log n): %.0f operations\n", analysis.TheoreticalOps)
+	fmt.Printf("📈 Theoretical %s: %.0f operations\n", analysis.Class, analysis.TheoreticalOps)
+	PrintOperations(operations)
 	fmt.Printf("⏱️  Time per operation: %.2f ns\n", analysis.TimePerOperation)
 }
 
+// PrintOperations displays the measured operation counts, or nothing when operations is nil
+func PrintOperations(operations *OperationCounter) {
+	if operations == nil {
+		return
+	}
+	fmt.Printf("🔢 Measured: %s comparisons, %s swaps, %s writes\n",
+		FormatNumber(int(operations.Comparisons)),
+		FormatNumber(int(operations.Swaps)),
+		FormatNumber(int(operations.Writes)))
+	fmt.Printf("🧮 Recursion depth: %d, auxiliary allocations: %s (%s elements)\n",
+		operations.MaxDepth,
+		FormatNumber(int(operations.Allocations)),
+		FormatNumber(int(operations.AllocatedElements)))
+}
+
 // PrintBenchmarkSummary displays a comprehensive benchmark summary
 func PrintBenchmarkSummary(summary BenchmarkSummary) {
-	fmt.Println("\n" + strings.Repeat("=", 60))
-	fmt.Println("               BENCHMARK SUMMARY")
-	fmt.Println(strings.Repeat("=", 60))
+	fmt.Println("\n" + strings.Repeat("=", 80))
+	fmt.Println("                          BENCHMARK SUMMARY")
+	fmt.Println(strings.Repeat("=", 80))
 	
-	fmt.Printf("%-12s %-15s %-15s %-10s\n", "Count", "Time", "μs/number", "Status")
-	fmt.Println(strings.Repeat("-", 60))
+	fmt.Printf("%-12s %-15s %-15s %-15s %-12s %-10s\n", "Count", "Median", "± StdDev", "P95", "μs/number", "Status")
+	fmt.Println(strings.Repeat("-", 80))
 	
 	for _, result := range summary.Results {
 		timePerNumber := float64(result.Duration.Nanoseconds()) / float64(result.Count) / 1000.0
@@ -111,26 +144,57 @@ func PrintBenchmarkSummary(summary BenchmarkSummary) {
 			status = "❌"
 		}
 		
-		fmt.Printf("%-12s %-15v %-15.2f %-10s\n", 
+		fmt.Printf("%-12s %-15v %-15v %-15v %-12.2f %-10s\n", 
 			FormatNumber(result.Count), 
 			result.Duration, 
+			result.Stats.StdDev, 
+			result.Stats.P95, 
 			timePerNumber, 
 			status)
 	}
 	
-	fmt.Println(strings.Repeat("=", 60))
+	fmt.Println(strings.Repeat("=", 80))
+	fmt.Printf("🎲 Input distribution: %s\n", summary.Distribution.Name())
+	fmt.Printf("⏱️  %d timed runs per size after %d warm-up, times exclude data generation\n", summary.Runs, summary.WarmupRuns)
 	
 	if len(summary.Scaling) > 0 {
 		fmt.Println("\n📊 SCALING ANALYSIS:")
 		for _, scale := range summary.Scaling {
-			fmt.Printf("   %s → %s: %.2fx size, %.2fx time\n", 
+			expected := summary.Class.Operations(scale.ToSize) / summary.Class.Operations(scale.FromSize)
+			fmt.Printf("   %s → %s: %.2fx size, %.2fx time (expected %.2fx)\n", 
 				FormatNumber(scale.FromSize), 
 				FormatNumber(scale.ToSize), 
 				scale.SizeRatio, 
-				scale.TimeRatio)
+				scale.TimeRatio,
+				expected)
 		}
 		
-		fmt.Println("\n💡 Note: Performance depends on the algorithm complexity.")
-		fmt.Println("   For O(n log n) algorithms: Expected time ratio for 2x size: ~2.2x time")
+		fmt.Printf("\n💡 Note: Expected ratios follow the declared %s complexity.\n", summary.Class)
+		fmt.Printf("   For %s algorithms: Expected time ratio for 2x size: ~%.1fx time\n",
+			summary.Class,
+			summary.Class.Operations(2000)/summary.Class.Operations(1000))
+	}
+
+	if summary.Baseline != "" {
+		fmt.Printf("\n🚀 SPEEDUP vs %s (%d CPUs, GOMAXPROCS %d):\n", summary.Baseline, summary.Environment.NumCPU, summary.Environment.MaxProcs)
+		for _, result := range summary.Results {
+			fmt.Printf("   %-12s %.2fx\n", FormatNumber(result.Count), result.Speedup)
+		}
+	}
+
+	if summary.Fit != nil {
+		fmt.Println("\n🔍 COMPLEXITY FIT:")
+		for _, model := range summary.Fit.Models {
+			fmt.Printf("   %-12s R² = %.4f\n", model.Model, model.RSquared)
+		}
+		fmt.Printf("   Best fit: %s (R² = %.4f), empirical exponent: n^%.2f\n",
+			summary.Fit.Class,
+			summary.Fit.RSquared,
+			summary.Fit.Exponent)
+		if summary.Fit.Class != summary.Class {
+			fmt.Printf("   ⚠️  Measured growth differs from the declared %s complexity\n", summary.Class)
+		}
+	} else {
+		fmt.Println("\n🔍 COMPLEXITY FIT: not enough data to fit, it needs at least three distinct sizes")
 	}
 }
@@ -0,0 +1,144 @@
+package pkg
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// MaxFuzzLength caps the slices decoded by IntsFromBytes, so that fuzzing the quadratic sorts stays fast
+const MaxFuzzLength = 1024
+
+// CheckSort verifies a copying sort on input: the output must be sorted, be a permutation of
+// the input, match slices.Sort and share no memory with the input, which must be left untouched
+// It returns nil when every property holds, or one joined error per broken property
+func CheckSort(sort func([]int) []int, input []int) error {
+	original := slices.Clone(input)
+	output := sort(input)
+
+	var errs []error
+	if !slices.Equal(input, original) {
+		errs = append(errs, errors.New("modified its input"))
+	}
+	if SameSlice(output, input) {
+		errs = append(errs, errors.New("returned its input slice instead of a copy"))
+	}
+	errs = append(errs, checkSorted(original, output))
+	return errors.Join(errs...)
+}
+
+// CheckSortInPlace verifies an in-place sort on a copy of input: the copy must end up sorted,
+// as a permutation of its original contents matching slices.Sort
+func CheckSortInPlace(sort func([]int), input []int) error {
+	arr := slices.Clone(input)
+	sort(arr)
+	return checkSorted(input, arr)
+}
+
+// CheckSorter verifies both methods of a Sorter on copies of input: Sort into a separate dst,
+// Sort with dst and src the same slice, and SortInPlace
+// On top of the sort properties, src must be left untouched and nothing past the input written
+func CheckSorter(sorter Sorter, input []int) error {
+	const sentinel = -1 << 62
+
+	var errs []error
+
+	src := slices.Clone(input)
+	dst := append(make([]int, len(src)), sentinel)
+	sorter.Sort(dst[:len(src)], src)
+	if !slices.Equal(src, input) {
+		errs = append(errs, errors.New("Sort modified src"))
+	}
+	if dst[len(src)] != sentinel {
+		errs = append(errs, errors.New("Sort wrote past the end of dst"))
+	}
+	if err := checkSorted(input, dst[:len(src)]); err != nil {
+		errs = append(errs, fmt.Errorf("Sort(dst, src): %w", err))
+	}
+
+	arr := slices.Clone(input)
+	sorter.Sort(arr, arr)
+	if err := checkSorted(input, arr); err != nil {
+		errs = append(errs, fmt.Errorf("Sort(arr, arr): %w", err))
+	}
+
+	backing := append(slices.Clone(input), sentinel)
+	sorter.SortInPlace(backing[:len(input)])
+	if backing[len(input)] != sentinel {
+		errs = append(errs, errors.New("SortInPlace wrote past the end of arr"))
+	}
+	if err := checkSorted(input, backing[:len(input)]); err != nil {
+		errs = append(errs, fmt.Errorf("SortInPlace: %w", err))
+	}
+
+	return errors.Join(errs...)
+}
+
+// checkSorted reports whether output is sorted, is a permutation of input and matches slices.Sort
+func checkSorted(input, output []int) error {
+	var errs []error
+
+	for i := 1; i < len(output); i++ {
+		if output[i-1] > output[i] {
+			errs = append(errs, fmt.Errorf("output is not sorted: output[%d] = %d > output[%d] = %d", i-1, output[i-1], i, output[i]))
+			break
+		}
+	}
+
+	// Multiset equality: every value must appear as many times in the output as in the input
+	counts := make(map[int]int, len(input))
+	for _, value := range input {
+		counts[value]++
+	}
+	for _, value := range output {
+		counts[value]--
+	}
+	for value, count := range counts {
+		if count != 0 {
+			errs = append(errs, fmt.Errorf("output is not a permutation of the input: %d appears %d more times in the input", value, count))
+			break
+		}
+	}
+
+	expected := slices.Sorted(slices.Values(input))
+	if len(output) != len(expected) {
+		errs = append(errs, fmt.Errorf("output has %d elements; slices.Sort gives %d", len(output), len(expected)))
+	} else if i := firstDifference(output, expected); i >= 0 {
+		errs = append(errs, fmt.Errorf("output differs from slices.Sort at %d: got %d, want %d", i, output[i], expected[i]))
+	}
+
+	return errors.Join(errs...)
+}
+
+// firstDifference returns the first index where a and b differ, or -1 when they are equal
+func firstDifference(a, b []int) int {
+	for i := range a {
+		if a[i] != b[i] {
+			return i
+		}
+	}
+	return -1
+}
+
+// IntsFromBytes decodes fuzzer bytes into at most MaxFuzzLength ints, two bytes per int
+// The 16-bit values keep the range small enough for Counting Sort while still producing
+// negatives and plenty of duplicates; a trailing odd byte is ignored
+func IntsFromBytes(data []byte) []int {
+	n := min(len(data)/2, MaxFuzzLength)
+	values := make([]int, n)
+	for i := range values {
+		values[i] = int(int16(binary.LittleEndian.Uint16(data[2*i:])))
+	}
+	return values
+}
+
+// BytesFromInts encodes values for IntsFromBytes, to seed a fuzz target with a table of inputs
+// Values outside the 16-bit range wrap around
+func BytesFromInts(values []int) []byte {
+	data := make([]byte, 2*len(values))
+	for i, value := range values {
+		binary.LittleEndian.PutUint16(data[2*i:], uint16(value))
+	}
+	return data
+}
@@ -0,0 +1,93 @@
+package pkg
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+// TestCheckSort tests that CheckSort passes a correct copying sort and names each broken property
+func TestCheckSort(t *testing.T) {
+	input := []int{3, -1, 3, 0, 2}
+
+	tests := []struct {
+		name    string
+		sort    func([]int) []int
+		wantErr string
+	}{
+		{"Correct", func(arr []int) []int { return slices.Sorted(slices.Values(arr)) }, ""},
+		{"Modifies input", func(arr []int) []int { slices.Sort(arr); return slices.Clone(arr) }, "modified its input"},
+		{"Returns input", func(arr []int) []int { slices.Sort(arr); return arr }, "returned its input slice"},
+		{"Not sorted", slices.Clone[[]int], "not sorted"},
+		{"Drops element", func(arr []int) []int { return slices.Sorted(slices.Values(arr[1:])) }, "not a permutation"},
+		{"Changes element", func(arr []int) []int {
+			sorted := slices.Sorted(slices.Values(arr))
+			sorted[0] = -5
+			return sorted
+		}, "differs from slices.Sort"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckSort(tt.sort, slices.Clone(input))
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("CheckSort() = %v; want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("CheckSort() = %v; want an error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestCheckSortInPlace tests that CheckSortInPlace works on a copy and catches an unsorted result
+func TestCheckSortInPlace(t *testing.T) {
+	input := []int{2, 1, 2}
+
+	if err := CheckSortInPlace(slices.Sort[[]int], input); err != nil {
+		t.Errorf("CheckSortInPlace(slices.Sort) = %v; want nil", err)
+	}
+	if !slices.Equal(input, []int{2, 1, 2}) {
+		t.Errorf("CheckSortInPlace modified its input to %v", input)
+	}
+	if err := CheckSortInPlace(func([]int) {}, input); err == nil {
+		t.Error("CheckSortInPlace(no-op) = nil; want an error")
+	}
+}
+
+// TestCheckSorter tests that CheckSorter passes SorterFunc and catches writes past the input
+func TestCheckSorter(t *testing.T) {
+	input := []int{5, -3, 5, 0}
+
+	if err := CheckSorter(SorterFunc(slices.Sort[[]int]), input); err != nil {
+		t.Errorf("CheckSorter(slices.Sort) = %v; want nil", err)
+	}
+
+	overflowing := SorterFunc(func(arr []int) {
+		slices.Sort(arr)
+		if cap(arr) > len(arr) {
+			arr[:len(arr)+1][len(arr)] = 0
+		}
+	})
+	if err := CheckSorter(overflowing, input); err == nil || !strings.Contains(err.Error(), "past the end") {
+		t.Errorf("CheckSorter(overflowing) = %v; want a write past the end", err)
+	}
+}
+
+// TestIntsFromBytes tests the decoding of fuzzer bytes and its round trip with BytesFromInts
+func TestIntsFromBytes(t *testing.T) {
+	values := []int{0, 1, -1, 32767, -32768, 42}
+	if got := IntsFromBytes(BytesFromInts(values)); !slices.Equal(got, values) {
+		t.Errorf("IntsFromBytes(BytesFromInts(%v)) = %v", values, got)
+	}
+
+	if got := IntsFromBytes([]byte{1, 0, 7}); !slices.Equal(got, []int{1}) {
+		t.Errorf("IntsFromBytes with an odd byte = %v; want [1]", got)
+	}
+	if got := IntsFromBytes(make([]byte, 4*MaxFuzzLength)); len(got) != MaxFuzzLength {
+		t.Errorf("len(IntsFromBytes) = %d; want %d", len(got), MaxFuzzLength)
+	}
+}
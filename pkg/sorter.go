@@ -0,0 +1,56 @@
+package pkg
+
+import "fmt"
+
+// Sorter is the interface implemented by every sorting algorithm of the module
+//
+// Sort writes the elements of src into dst[:len(src)] in ascending order and never modifies src,
+// unless dst and src are the same slice, in which case it sorts that slice in place
+// Any other overlap between dst and src is not supported, and a dst shorter than src panics
+// SortInPlace sorts arr and leaves the result in arr itself
+//
+// Neither method keeps a reference to its arguments after returning, and neither
+// writes outside dst[:len(src)] or arr, whatever the length of the input
+type Sorter interface {
+	Sort(dst, src []int)
+	SortInPlace(arr []int)
+}
+
+// SorterFunc adapts a function that sorts a slice in place to the Sorter interface
+// Sort copies src into dst and sorts dst, so it never touches src
+type SorterFunc func(arr []int)
+
+// Sort copies src into dst and sorts dst[:len(src)]
+func (f SorterFunc) Sort(dst, src []int) {
+	dst = SortDestination(dst, src)
+	copy(dst, src)
+	f(dst)
+}
+
+// SortInPlace sorts arr with f
+func (f SorterFunc) SortInPlace(arr []int) {
+	f(arr)
+}
+
+// SortDestination checks that dst can hold src and returns dst[:len(src)]
+// It panics when dst is shorter than src, as required by Sorter.Sort
+func SortDestination(dst, src []int) []int {
+	if len(dst) < len(src) {
+		panic(fmt.Sprintf("pkg: sort destination has %d elements, source has %d", len(dst), len(src)))
+	}
+	return dst[:len(src)]
+}
+
+// SameSlice reports whether a and b start at the same element
+// Sorters that cannot sort from src into dst directly use it to detect Sort(arr, arr)
+func SameSlice(a, b []int) bool {
+	return len(a) > 0 && len(b) > 0 && &a[0] == &b[0]
+}
+
+// SortCopy returns a sorted copy of src made by sorter, leaving src untouched
+// The copy never shares memory with src, even when src is empty or has a single element
+func SortCopy(sorter Sorter, src []int) []int {
+	dst := make([]int, len(src))
+	sorter.Sort(dst, src)
+	return dst
+}
@@ -0,0 +1,102 @@
+package pkg
+
+import (
+	"slices"
+	"testing"
+)
+
+// sortInts is the in-place sort adapted by the SorterFunc tests
+var sortInts = SorterFunc(slices.Sort[[]int])
+
+// TestSorterFuncSort tests that Sort fills dst without touching src or anything past len(src)
+func TestSorterFuncSort(t *testing.T) {
+	src := []int{3, 1, 2}
+	dst := []int{-1, -1, -1, -1}
+
+	sortInts.Sort(dst, src)
+
+	if !slices.Equal(src, []int{3, 1, 2}) {
+		t.Errorf("src = %v; want it unchanged", src)
+	}
+	if !slices.Equal(dst, []int{1, 2, 3, -1}) {
+		t.Errorf("dst = %v; want [1 2 3 -1]", dst)
+	}
+}
+
+// TestSorterFuncSortSameSlice tests that Sort(arr, arr) sorts arr in place
+func TestSorterFuncSortSameSlice(t *testing.T) {
+	arr := []int{3, 1, 2}
+	sortInts.Sort(arr, arr)
+
+	if !slices.Equal(arr, []int{1, 2, 3}) {
+		t.Errorf("arr = %v; want [1 2 3]", arr)
+	}
+}
+
+// TestSorterFuncSortShortDestination tests that a destination shorter than the source panics
+// even when its capacity would be large enough
+func TestSorterFuncSortShortDestination(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Sort did not panic")
+		}
+	}()
+
+	dst := make([]int, 2, 8)
+	sortInts.Sort(dst, []int{3, 1, 2})
+}
+
+// TestSortCopy tests that SortCopy never returns memory shared with its input
+func TestSortCopy(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		expected []int
+	}{
+		{"Empty", []int{}, []int{}},
+		{"Single", []int{5}, []int{5}},
+		{"Several", []int{3, 1, 2}, []int{1, 2, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := slices.Clone(tt.input)
+			sorted := SortCopy(sortInts, tt.input)
+
+			if !slices.Equal(sorted, tt.expected) {
+				t.Errorf("SortCopy(%v) = %v; want %v", original, sorted, tt.expected)
+			}
+			if !slices.Equal(tt.input, original) {
+				t.Errorf("input = %v; want it unchanged", tt.input)
+			}
+			if len(sorted) > 0 && SameSlice(sorted, tt.input) {
+				t.Error("SortCopy returned the input slice")
+			}
+		})
+	}
+}
+
+// TestSameSlice tests which slices are reported as the same
+func TestSameSlice(t *testing.T) {
+	arr := []int{1, 2, 3}
+
+	tests := []struct {
+		name     string
+		a, b     []int
+		expected bool
+	}{
+		{"Identical", arr, arr, true},
+		{"Shorter prefix", arr, arr[:1], true},
+		{"Offset", arr, arr[1:], false},
+		{"Copy", arr, slices.Clone(arr), false},
+		{"Empty", arr[:0], arr[:0], false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SameSlice(tt.a, tt.b); got != tt.expected {
+				t.Errorf("SameSlice() = %t; want %t", got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package pkg
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+)
+
+// Record is a key tagged with its position in the input, so that a sort which
+// reorders equal keys can be told apart from one that keeps them in input order
+type Record struct {
+	Key   int // value the records are sorted by
+	Index int // position of the record in the unsorted input
+}
+
+// CompareRecords compares records by key only, so records with equal keys compare as equal
+func CompareRecords(a, b Record) int {
+	return cmp.Compare(a.Key, b.Key)
+}
+
+// RecordKey returns the key of a record, for the key-based forms of the integer sorts
+func RecordKey(r Record) int {
+	return r.Key
+}
+
+// NewRecords tags every key with its index
+func NewRecords(keys []int) []Record {
+	records := make([]Record, len(keys))
+	for i, key := range keys {
+		records[i] = Record{Key: key, Index: i}
+	}
+	return records
+}
+
+// StabilityReport describes how a sort treated records with equal keys
+type StabilityReport struct {
+	Case       string // name of the input the report is about, empty for a single CheckStability
+	Sorted     bool   // keys are in ascending order
+	Complete   bool   // every input record appears exactly once, unchanged
+	Violations int    // adjacent records with equal keys whose input order was reversed
+	First      int    // position in the output of the first violation, -1 when there is none
+}
+
+// Stable reports whether the output was a correct sort that kept equal keys in input order
+func (r StabilityReport) Stable() bool {
+	return r.Sorted && r.Complete && r.Violations == 0
+}
+
+// String summarizes the report in one line
+func (r StabilityReport) String() string {
+	var status string
+	switch {
+	case !r.Complete:
+		status = "lost or changed records"
+	case !r.Sorted:
+		status = "not sorted"
+	case r.Violations > 0:
+		status = fmt.Sprintf("unstable, %d equal-key pairs reordered, first at position %d", r.Violations, r.First)
+	default:
+		status = "stable"
+	}
+
+	if r.Case == "" {
+		return status
+	}
+	return r.Case + ": " + status
+}
+
+// CheckStability compares a sorted output with the input it was sorted from
+func CheckStability(input, sorted []Record) StabilityReport {
+	report := StabilityReport{Sorted: true, Complete: len(input) == len(sorted), First: -1}
+
+	seen := make([]bool, len(input))
+	for i, record := range sorted {
+		if record.Index < 0 || record.Index >= len(input) || seen[record.Index] || input[record.Index] != record {
+			report.Complete = false
+		} else {
+			seen[record.Index] = true
+		}
+
+		if i == 0 {
+			continue
+		}
+		previous := sorted[i-1]
+		if previous.Key > record.Key {
+			report.Sorted = false
+		}
+		if previous.Key == record.Key && previous.Index > record.Index {
+			report.Violations++
+			if report.First < 0 {
+				report.First = i
+			}
+		}
+	}
+
+	return report
+}
+
+// StabilityCase is a named input of the stability harness
+type StabilityCase struct {
+	Name    string
+	Records []Record
+}
+
+// StabilityCases returns inputs of n records whose keys repeat often enough to expose an unstable sort
+func StabilityCases(generator *RandomGenerator, n int) []StabilityCase {
+	keys := max(n/8, 2)
+
+	return []StabilityCase{
+		{Name: "Few unique", Records: NewRecords(generator.GenerateIntSlice(n, 0, keys-1))},
+		{Name: "Two keys", Records: NewRecords(generator.GenerateIntSlice(n, 0, 1))},
+		{Name: "Reverse sorted", Records: NewRecords(generator.GenerateReverseSortedSlice(n, 0, keys-1))},
+		{Name: "Organ pipe", Records: NewRecords(generator.GenerateOrganPipeSlice(n))},
+		{Name: "All equal", Records: NewRecords(generator.GenerateAllEqualSlice(n, 0))},
+	}
+}
+
+// VerifyStability sorts a copy of every case with sort, which may sort in place and return its argument
+// It returns the report of the first case the sort got wrong, or of the last case when it kept every
+// case stable, so Stable on the result tells whether the sort passed the whole harness
+func VerifyStability(sort func([]Record) []Record, cases []StabilityCase) StabilityReport {
+	report := StabilityReport{Sorted: true, Complete: true, First: -1}
+
+	for _, c := range cases {
+		report = CheckStability(c.Records, sort(slices.Clone(c.Records)))
+		report.Case = c.Name
+		if !report.Stable() {
+			return report
+		}
+	}
+
+	return report
+}
@@ -0,0 +1,111 @@
+package pkg
+
+import (
+	"slices"
+	"testing"
+)
+
+// TestCheckStability tests the report for correct, unstable, unsorted and incomplete outputs
+func TestCheckStability(t *testing.T) {
+	input := NewRecords([]int{3, 1, 3, 2, 1})
+
+	tests := []struct {
+		name     string
+		sorted   []Record
+		expected StabilityReport
+	}{
+		{
+			name:     "Stable",
+			sorted:   []Record{{1, 1}, {1, 4}, {2, 3}, {3, 0}, {3, 2}},
+			expected: StabilityReport{Sorted: true, Complete: true, First: -1},
+		},
+		{
+			name:     "Equal keys swapped",
+			sorted:   []Record{{1, 4}, {1, 1}, {2, 3}, {3, 2}, {3, 0}},
+			expected: StabilityReport{Sorted: true, Complete: true, Violations: 2, First: 1},
+		},
+		{
+			name:     "Not sorted",
+			sorted:   []Record{{1, 1}, {1, 4}, {3, 0}, {2, 3}, {3, 2}},
+			expected: StabilityReport{Sorted: false, Complete: true, First: -1},
+		},
+		{
+			name:     "Duplicated record",
+			sorted:   []Record{{1, 1}, {1, 1}, {2, 3}, {3, 0}, {3, 2}},
+			expected: StabilityReport{Sorted: true, Complete: false, First: -1},
+		},
+		{
+			name:     "Changed key",
+			sorted:   []Record{{1, 1}, {1, 4}, {2, 3}, {3, 0}, {4, 2}},
+			expected: StabilityReport{Sorted: true, Complete: false, First: -1},
+		},
+		{
+			name:     "Missing record",
+			sorted:   []Record{{1, 1}, {1, 4}, {2, 3}, {3, 0}},
+			expected: StabilityReport{Sorted: true, Complete: false, First: -1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := CheckStability(input, tt.sorted)
+			if report != tt.expected {
+				t.Errorf("CheckStability() = %+v; want %+v", report, tt.expected)
+			}
+			if report.Stable() != (tt.name == "Stable") {
+				t.Errorf("Stable() = %t for %s", report.Stable(), report)
+			}
+		})
+	}
+}
+
+// TestVerifyStability tests that the harness passes a stable sort and catches one that reverses equal keys
+func TestVerifyStability(t *testing.T) {
+	cases := StabilityCases(NewRandomGeneratorWithSeed(1), 200)
+
+	stable := func(records []Record) []Record {
+		slices.SortStableFunc(records, CompareRecords)
+		return records
+	}
+	if report := VerifyStability(stable, cases); !report.Stable() {
+		t.Errorf("VerifyStability(SortStableFunc) = %s; want stable", report)
+	}
+
+	// Sorting by key and then by descending index reverses every group of equal keys
+	reversed := func(records []Record) []Record {
+		slices.SortFunc(records, func(a, b Record) int {
+			if c := CompareRecords(a, b); c != 0 {
+				return c
+			}
+			return b.Index - a.Index
+		})
+		return records
+	}
+	report := VerifyStability(reversed, cases)
+	if report.Stable() || !report.Sorted || !report.Complete || report.Violations == 0 {
+		t.Errorf("VerifyStability(reversed) = %+v; want a sorted, complete, unstable report", report)
+	}
+	if report.Case != cases[0].Name {
+		t.Errorf("report.Case = %q; want the first case %q", report.Case, cases[0].Name)
+	}
+}
+
+// TestStabilityCases tests that every case has the requested size and repeats keys
+func TestStabilityCases(t *testing.T) {
+	for _, c := range StabilityCases(NewRandomGeneratorWithSeed(1), 64) {
+		if len(c.Records) != 64 {
+			t.Errorf("%s has %d records; want 64", c.Name, len(c.Records))
+		}
+
+		keys := make([]int, len(c.Records))
+		for i, record := range c.Records {
+			keys[i] = record.Key
+			if record.Index != i {
+				t.Errorf("%s record %d has index %d", c.Name, i, record.Index)
+			}
+		}
+		if !HasDuplicates(keys) {
+			t.Errorf("%s has no repeated keys", c.Name)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package pkg
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// DurationStats summarizes the timings of repeated runs on the same input size
+type DurationStats struct {
+	Runs   int           `json:"runs"`
+	Min    time.Duration `json:"min_ns"`
+	Median time.Duration `json:"median_ns"`
+	Mean   time.Duration `json:"mean_ns"`
+	StdDev time.Duration `json:"stddev_ns"` // sample standard deviation, 0 for a single run
+	P95    time.Duration `json:"p95_ns"`
+	CILow  time.Duration `json:"ci_low_ns"`  // lower bound of the 95% confidence interval of the mean
+	CIHigh time.Duration `json:"ci_high_ns"` // upper bound of the 95% confidence interval of the mean
+}
+
+// tCritical95 holds the two-sided 95% critical values of Student's t for 1 to 30 degrees of freedom
+var tCritical95 = []float64{
+	12.706, 4.303, 3.182, 2.776, 2.571, 2.447, 2.365, 2.306, 2.262, 2.228,
+	2.201, 2.179, 2.160, 2.145, 2.131, 2.120, 2.110, 2.101, 2.093, 2.086,
+	2.080, 2.074, 2.069, 2.064, 2.060, 2.056, 2.052, 2.048, 2.045, 2.042,
+}
+
+// CalculateDurationStats computes order statistics, mean, deviation and a 95% confidence interval
+// The interval uses Student's t distribution, so it stays honest for the small run counts of a benchmark
+func CalculateDurationStats(samples []time.Duration) DurationStats {
+	if len(samples) == 0 {
+		return DurationStats{}
+	}
+
+	sorted := make([]float64, len(samples))
+	for i, sample := range samples {
+		sorted[i] = float64(sample)
+	}
+	sort.Float64s(sorted)
+
+	m := mean(sorted)
+	stats := DurationStats{
+		Runs:   len(sorted),
+		Min:    time.Duration(sorted[0]),
+		Median: time.Duration(percentile(sorted, 50)),
+		Mean:   time.Duration(m),
+		P95:    time.Duration(percentile(sorted, 95)),
+		CILow:  time.Duration(m),
+		CIHigh: time.Duration(m),
+	}
+
+	if len(sorted) > 1 {
+		n := float64(len(sorted))
+		stdDev := math.Sqrt(variance(sorted) * n / (n - 1))
+		margin := tCritical(len(sorted)-1) * stdDev / math.Sqrt(n)
+
+		stats.StdDev = time.Duration(stdDev)
+		stats.CILow = time.Duration(math.Max(0, m-margin))
+		stats.CIHigh = time.Duration(m + margin)
+	}
+
+	return stats
+}
+
+// percentile returns the p-th percentile of sorted values, interpolating between the closest ranks
+func percentile(sorted []float64, p float64) float64 {
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	return sorted[lower] + (sorted[upper]-sorted[lower])*(rank-float64(lower))
+}
+
+// tCritical returns the two-sided 95% critical value of Student's t for the degrees of freedom
+// Beyond the table it falls back to the normal approximation
+func tCritical(degreesOfFreedom int) float64 {
+	if degreesOfFreedom >= 1 && degreesOfFreedom <= len(tCritical95) {
+		return tCritical95[degreesOfFreedom-1]
+	}
+	return 1.96
+}
@@ -0,0 +1,60 @@
+package pkg
+
+import (
+	"testing"
+	"time"
+)
+
+// TestCalculateDurationStats tests order statistics, deviation and the confidence interval
+func TestCalculateDurationStats(t *testing.T) {
+	testCases := []struct {
+		name     string
+		samples  []time.Duration
+		expected DurationStats
+	}{
+		{
+			name:     "Empty",
+			samples:  nil,
+			expected: DurationStats{},
+		},
+		{
+			name:     "Single run",
+			samples:  []time.Duration{5 * time.Millisecond},
+			expected: DurationStats{Runs: 1, Min: 5 * time.Millisecond, Median: 5 * time.Millisecond, Mean: 5 * time.Millisecond, P95: 5 * time.Millisecond, CILow: 5 * time.Millisecond, CIHigh: 5 * time.Millisecond},
+		},
+		{
+			// mean 25, sample stddev 12.9099, t(3) = 3.182, margin = 3.182 * 12.9099 / 2 = 20.5396
+			name:     "Unsorted samples",
+			samples:  []time.Duration{40, 10, 30, 20},
+			expected: DurationStats{Runs: 4, Min: 10, Median: 25, Mean: 25, StdDev: 12, P95: 38, CILow: 4, CIHigh: 45},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			stats := CalculateDurationStats(tc.samples)
+			if stats != tc.expected {
+				t.Errorf("CalculateDurationStats(%v) = %+v; want %+v", tc.samples, stats, tc.expected)
+			}
+		})
+	}
+}
+
+// TestCalculateDurationStatsIntervalNarrows tests that more runs with the same spread give a tighter interval
+func TestCalculateDurationStatsIntervalNarrows(t *testing.T) {
+	few := CalculateDurationStats([]time.Duration{90, 110, 90, 110})
+
+	var samples []time.Duration
+	for i := 0; i < 20; i++ {
+		samples = append(samples, 90, 110)
+	}
+	many := CalculateDurationStats(samples)
+
+	if many.CIHigh-many.CILow >= few.CIHigh-few.CILow {
+		t.Errorf("interval with 40 runs [%v, %v] is not narrower than with 4 runs [%v, %v]",
+			many.CILow, many.CIHigh, few.CILow, few.CIHigh)
+	}
+	if many.CILow > 100 || many.CIHigh < 100 {
+		t.Errorf("interval [%v, %v] does not contain the mean 100", many.CILow, many.CIHigh)
+	}
+}
@@ -0,0 +1,470 @@
+package pkg
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+// TraceEventKind identifies what a traced sort did in a TraceEvent
+type TraceEventKind uint8
+
+const (
+	TraceCompare    TraceEventKind = iota // compared the elements at I and J
+	TraceSwap                             // exchanged the elements at I and J
+	TraceWrite                            // stored Value at I
+	TracePivot                            // chose the element at I as the pivot of the range being partitioned
+	TraceMergeBegin                       // started merging the sorted runs of the range I..J
+	TraceMergeEnd                         // finished merging the range I..J, which is now sorted
+	TraceEnter                            // entered a recursive call on the range I..J
+	TraceExit                             // returned from the recursive call on the range I..J
+)
+
+// traceEventNames holds the name of each TraceEventKind, in declaration order
+var traceEventNames = []string{"compare", "swap", "write", "pivot", "merge-begin", "merge-end", "enter", "exit"}
+
+// TraceEventKinds returns every event kind in declaration order
+func TraceEventKinds() []TraceEventKind {
+	kinds := make([]TraceEventKind, len(traceEventNames))
+	for i := range kinds {
+		kinds[i] = TraceEventKind(i)
+	}
+	return kinds
+}
+
+// String returns the name of the kind used in JSON-lines traces, e.g. "merge-begin"
+func (k TraceEventKind) String() string {
+	if int(k) >= len(traceEventNames) {
+		return fmt.Sprintf("TraceEventKind(%d)", int(k))
+	}
+	return traceEventNames[k]
+}
+
+// MarshalText encodes the kind by name, so JSON traces stay readable
+func (k TraceEventKind) MarshalText() ([]byte, error) {
+	if int(k) >= len(traceEventNames) {
+		return nil, fmt.Errorf("unknown trace event kind %d", int(k))
+	}
+	return []byte(traceEventNames[k]), nil
+}
+
+// UnmarshalText decodes a kind from its name
+func (k *TraceEventKind) UnmarshalText(text []byte) error {
+	index := slices.Index(traceEventNames, string(text))
+	if index < 0 {
+		return fmt.Errorf("unknown trace event kind %q", text)
+	}
+	*k = TraceEventKind(index)
+	return nil
+}
+
+// TraceEvent is a single step of a traced sort
+// Indices are positions in the array being sorted; merges compare the left run from its
+// buffered copy but still report the positions its elements were copied from
+type TraceEvent struct {
+	Kind  TraceEventKind `json:"e"`
+	I     int            `json:"i,omitempty"`
+	J     int            `json:"j,omitempty"` // unused by write and pivot events
+	Value int            `json:"v,omitempty"` // value stored by a write event
+}
+
+// String describes the event in one line, e.g. "swap 3 4" or "write 2 = 17"
+func (e TraceEvent) String() string {
+	switch e.Kind {
+	case TraceWrite:
+		return fmt.Sprintf("%s %d = %d", e.Kind, e.I, e.Value)
+	case TracePivot:
+		return fmt.Sprintf("%s %d", e.Kind, e.I)
+	default:
+		return fmt.Sprintf("%s %d %d", e.Kind, e.I, e.J)
+	}
+}
+
+// Tracer records the events of a traced sort
+// A nil *Tracer is valid and records nothing, like a nil OperationCounter
+type Tracer struct {
+	events []TraceEvent
+}
+
+// NewTracer creates a Tracer with no events
+func NewTracer() *Tracer {
+	return &Tracer{}
+}
+
+// Compare records a comparison of the elements at i and j
+func (t *Tracer) Compare(i, j int) {
+	t.record(TraceEvent{Kind: TraceCompare, I: i, J: j})
+}
+
+// Swap records an exchange of the elements at i and j
+func (t *Tracer) Swap(i, j int) {
+	t.record(TraceEvent{Kind: TraceSwap, I: i, J: j})
+}
+
+// Write records storing value at i
+func (t *Tracer) Write(i, value int) {
+	t.record(TraceEvent{Kind: TraceWrite, I: i, Value: value})
+}
+
+// Pivot records choosing the element at i as a pivot
+func (t *Tracer) Pivot(i int) {
+	t.record(TraceEvent{Kind: TracePivot, I: i})
+}
+
+// MergeBegin records the start of a merge of the range low..high
+func (t *Tracer) MergeBegin(low, high int) {
+	t.record(TraceEvent{Kind: TraceMergeBegin, I: low, J: high})
+}
+
+// MergeEnd records the end of a merge of the range low..high
+func (t *Tracer) MergeEnd(low, high int) {
+	t.record(TraceEvent{Kind: TraceMergeEnd, I: low, J: high})
+}
+
+// Enter records entering a recursive call on the range low..high
+func (t *Tracer) Enter(low, high int) {
+	t.record(TraceEvent{Kind: TraceEnter, I: low, J: high})
+}
+
+// Exit records returning from a recursive call on the range low..high
+func (t *Tracer) Exit(low, high int) {
+	t.record(TraceEvent{Kind: TraceExit, I: low, J: high})
+}
+
+// Events returns the recorded events in order, nil for a nil Tracer
+func (t *Tracer) Events() []TraceEvent {
+	if t == nil {
+		return nil
+	}
+	return t.events
+}
+
+func (t *Tracer) record(event TraceEvent) {
+	if t != nil {
+		t.events = append(t.events, event)
+	}
+}
+
+// RecordWrite records in t storing value at i from a generic sort, so its helpers can take a tracer
+// the way they take an OperationCounter
+// Traces replay []int, so writes of any other element type are not recorded
+func RecordWrite[T any](t *Tracer, i int, value T) {
+	if t == nil {
+		return
+	}
+	if v, ok := any(value).(int); ok {
+		t.Write(i, v)
+	}
+}
+
+// Trace is a recorded sorting run: the algorithm, the input it was given and every event it produced
+type Trace struct {
+	Algorithm string
+	Input     []int
+	Events    []TraceEvent
+}
+
+// RecordTrace runs sort on a copy of input with a new Tracer and returns the recorded trace
+func RecordTrace(algorithm string, sort func(arr []int, tracer *Tracer) []int, input []int) Trace {
+	tracer := NewTracer()
+	sort(slices.Clone(input), tracer)
+	return Trace{Algorithm: algorithm, Input: slices.Clone(input), Events: tracer.Events()}
+}
+
+// TraceFormat identifies how a Trace is stored in a file
+type TraceFormat string
+
+const (
+	TraceJSONLines TraceFormat = "jsonl"  // a header line followed by one JSON object per event
+	TraceBinary    TraceFormat = "binary" // a magic header followed by varint-encoded fields
+)
+
+// traceVersion is the version written in the header of both formats
+const traceVersion = 1
+
+// traceMagic starts every binary trace and tells it apart from a JSON-lines one
+const traceMagic = "SORTTRC"
+
+// ParseTraceFormat maps a format name to a TraceFormat, accepting "json" for JSON lines
+func ParseTraceFormat(name string) (TraceFormat, error) {
+	switch strings.ToLower(name) {
+	case "jsonl", "json":
+		return TraceJSONLines, nil
+	case "binary", "bin":
+		return TraceBinary, nil
+	default:
+		return "", fmt.Errorf("unknown trace format %q", name)
+	}
+}
+
+// traceHeader is the first line of a JSON-lines trace
+type traceHeader struct {
+	Version   int    `json:"version"`
+	Algorithm string `json:"algorithm"`
+	Input     []int  `json:"input"`
+}
+
+// WriteTrace writes trace to w in the given format
+func WriteTrace(w io.Writer, format TraceFormat, trace Trace) error {
+	switch format {
+	case TraceJSONLines:
+		return writeTraceJSONLines(w, trace)
+	case TraceBinary:
+		return writeTraceBinary(w, trace)
+	default:
+		return fmt.Errorf("unknown trace format %q", format)
+	}
+}
+
+// ReadTrace reads a trace written by WriteTrace, detecting its format from the first bytes
+func ReadTrace(r io.Reader) (Trace, error) {
+	reader := bufio.NewReader(r)
+
+	magic, err := reader.Peek(len(traceMagic))
+	if err == nil && string(magic) == traceMagic {
+		return readTraceBinary(reader)
+	}
+	return readTraceJSONLines(reader)
+}
+
+func writeTraceJSONLines(w io.Writer, trace Trace) error {
+	buffered := bufio.NewWriter(w)
+	encoder := json.NewEncoder(buffered)
+
+	input := trace.Input
+	if input == nil {
+		input = []int{}
+	}
+	if err := encoder.Encode(traceHeader{Version: traceVersion, Algorithm: trace.Algorithm, Input: input}); err != nil {
+		return err
+	}
+	for _, event := range trace.Events {
+		if err := encoder.Encode(event); err != nil {
+			return err
+		}
+	}
+	return buffered.Flush()
+}
+
+func readTraceJSONLines(r io.Reader) (Trace, error) {
+	decoder := json.NewDecoder(r)
+
+	var header traceHeader
+	if err := decoder.Decode(&header); err != nil {
+		if errors.Is(err, io.EOF) {
+			return Trace{}, errors.New("empty trace")
+		}
+		return Trace{}, fmt.Errorf("trace header: %w", err)
+	}
+	if header.Version != traceVersion {
+		return Trace{}, fmt.Errorf("unsupported trace version %d", header.Version)
+	}
+
+	trace := Trace{Algorithm: header.Algorithm, Input: header.Input}
+	for {
+		var event TraceEvent
+		if err := decoder.Decode(&event); err != nil {
+			if errors.Is(err, io.EOF) {
+				return trace, nil
+			}
+			return Trace{}, fmt.Errorf("trace event %d: %w", len(trace.Events)+1, err)
+		}
+		trace.Events = append(trace.Events, event)
+	}
+}
+
+// writeTraceBinary writes the magic and version, the algorithm and input, then every event as
+// its kind byte followed by its operands as signed varints: I and Value for writes, I for pivots,
+// I and J for the other kinds
+func writeTraceBinary(w io.Writer, trace Trace) error {
+	buffered := bufio.NewWriter(w)
+	scratch := make([]byte, binary.MaxVarintLen64)
+
+	putUvarint := func(value uint64) {
+		buffered.Write(scratch[:binary.PutUvarint(scratch, value)])
+	}
+	putVarint := func(value int) {
+		buffered.Write(scratch[:binary.PutVarint(scratch, int64(value))])
+	}
+
+	buffered.WriteString(traceMagic)
+	buffered.WriteByte(traceVersion)
+	putUvarint(uint64(len(trace.Algorithm)))
+	buffered.WriteString(trace.Algorithm)
+	putUvarint(uint64(len(trace.Input)))
+	for _, value := range trace.Input {
+		putVarint(value)
+	}
+
+	for _, event := range trace.Events {
+		if int(event.Kind) >= len(traceEventNames) {
+			return fmt.Errorf("unknown trace event kind %d", int(event.Kind))
+		}
+		buffered.WriteByte(byte(event.Kind))
+		putVarint(event.I)
+		switch event.Kind {
+		case TraceWrite:
+			putVarint(event.Value)
+		case TracePivot:
+		default:
+			putVarint(event.J)
+		}
+	}
+	return buffered.Flush()
+}
+
+func readTraceBinary(r *bufio.Reader) (Trace, error) {
+	header := make([]byte, len(traceMagic)+1)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return Trace{}, fmt.Errorf("trace header: %w", err)
+	}
+	if version := header[len(traceMagic)]; version != traceVersion {
+		return Trace{}, fmt.Errorf("unsupported trace version %d", version)
+	}
+
+	var err error
+	readVarint := func() int {
+		if err != nil {
+			return 0
+		}
+		var value int64
+		value, err = binary.ReadVarint(r)
+		return int(value)
+	}
+
+	nameLength, err := binary.ReadUvarint(r)
+	if err != nil {
+		return Trace{}, fmt.Errorf("trace header: %w", err)
+	}
+	var name bytes.Buffer
+	if _, err := io.CopyN(&name, r, int64(nameLength)); err != nil {
+		return Trace{}, fmt.Errorf("trace header: %w", err)
+	}
+
+	inputLength, err := binary.ReadUvarint(r)
+	if err != nil {
+		return Trace{}, fmt.Errorf("trace header: %w", err)
+	}
+	trace := Trace{Algorithm: name.String(), Input: make([]int, 0, min(inputLength, 1<<16))}
+	for range inputLength {
+		trace.Input = append(trace.Input, readVarint())
+	}
+	if err != nil {
+		return Trace{}, fmt.Errorf("trace input: %w", unexpectedEOF(err))
+	}
+
+	for {
+		kind, kindErr := r.ReadByte()
+		if errors.Is(kindErr, io.EOF) {
+			return trace, nil
+		}
+		if kindErr != nil {
+			return Trace{}, kindErr
+		}
+		if int(kind) >= len(traceEventNames) {
+			return Trace{}, fmt.Errorf("trace event %d: unknown kind %d", len(trace.Events)+1, kind)
+		}
+
+		event := TraceEvent{Kind: TraceEventKind(kind), I: readVarint()}
+		switch event.Kind {
+		case TraceWrite:
+			event.Value = readVarint()
+		case TracePivot:
+		default:
+			event.J = readVarint()
+		}
+		if err != nil {
+			return Trace{}, fmt.Errorf("trace event %d: %w", len(trace.Events)+1, unexpectedEOF(err))
+		}
+		trace.Events = append(trace.Events, event)
+	}
+}
+
+// unexpectedEOF turns io.EOF into io.ErrUnexpectedEOF, since a truncated field is never a clean end
+func unexpectedEOF(err error) error {
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// ReplayTrace applies the swaps and writes of trace to a copy of its input and calls step after every
+// event with the state at that point, which step must not retain; step may be nil
+// It returns the final state, or an error when an event touches an index outside the input
+func ReplayTrace(trace Trace, step func(state []int, event TraceEvent)) ([]int, error) {
+	state := slices.Clone(trace.Input)
+	inRange := func(i int) bool { return i >= 0 && i < len(state) }
+
+	for n, event := range trace.Events {
+		switch event.Kind {
+		case TraceCompare, TraceSwap:
+			if !inRange(event.I) || !inRange(event.J) {
+				return nil, fmt.Errorf("trace event %d (%v): index out of range for %d elements", n+1, event, len(state))
+			}
+			if event.Kind == TraceSwap {
+				state[event.I], state[event.J] = state[event.J], state[event.I]
+			}
+		case TraceWrite, TracePivot:
+			if !inRange(event.I) {
+				return nil, fmt.Errorf("trace event %d (%v): index out of range for %d elements", n+1, event, len(state))
+			}
+			if event.Kind == TraceWrite {
+				state[event.I] = event.Value
+			}
+		}
+
+		if step != nil {
+			step(state, event)
+		}
+	}
+	return state, nil
+}
+
+// TraceSummary condenses a trace into event counts and the outcome of replaying it
+type TraceSummary struct {
+	Algorithm string
+	Length    int                    // number of input elements
+	Events    int                    // total number of events
+	Counts    map[TraceEventKind]int // events of each kind, kinds that never occur are absent
+	MaxDepth  int                    // deepest nesting of enter events, 0 for iterative sorts
+	Result    []int                  // state after replaying every event
+	Sorted    bool                   // Result is the input in sorted order
+}
+
+// SummarizeTrace replays trace and counts its events
+func SummarizeTrace(trace Trace) (TraceSummary, error) {
+	summary := TraceSummary{
+		Algorithm: trace.Algorithm,
+		Length:    len(trace.Input),
+		Events:    len(trace.Events),
+		Counts:    make(map[TraceEventKind]int),
+	}
+
+	depth := 0
+	result, err := ReplayTrace(trace, func(state []int, event TraceEvent) {
+		summary.Counts[event.Kind]++
+		switch event.Kind {
+		case TraceEnter:
+			depth++
+			summary.MaxDepth = max(summary.MaxDepth, depth)
+		case TraceExit:
+			depth--
+		}
+	})
+	if err != nil {
+		return TraceSummary{}, err
+	}
+
+	expected := slices.Clone(trace.Input)
+	slices.Sort(expected)
+
+	summary.Result = result
+	summary.Sorted = slices.Equal(result, expected)
+	return summary, nil
+}
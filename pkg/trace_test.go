@@ -0,0 +1,262 @@
+package pkg
+
+import (
+	"bytes"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// sampleTrace sorts [3, -1, 2] and uses every event kind
+var sampleTrace = Trace{
+	Algorithm: "sample",
+	Input:     []int{3, -1, 2},
+	Events: []TraceEvent{
+		{Kind: TraceEnter, I: 0, J: 2},
+		{Kind: TracePivot, I: 2},
+		{Kind: TraceCompare, I: 0, J: 2},
+		{Kind: TraceSwap, I: 0, J: 1},
+		{Kind: TraceMergeBegin, I: 1, J: 2},
+		{Kind: TraceWrite, I: 1, Value: 2},
+		{Kind: TraceWrite, I: 2, Value: 3},
+		{Kind: TraceMergeEnd, I: 1, J: 2},
+		{Kind: TraceExit, I: 0, J: 2},
+	},
+}
+
+// TestTracer tests that a Tracer records events in order and that a nil Tracer records nothing
+func TestTracer(t *testing.T) {
+	tracer := NewTracer()
+	tracer.Enter(0, 2)
+	tracer.Pivot(2)
+	tracer.Compare(0, 2)
+	tracer.Swap(0, 1)
+	tracer.MergeBegin(1, 2)
+	tracer.Write(1, 2)
+	tracer.Write(2, 3)
+	tracer.MergeEnd(1, 2)
+	tracer.Exit(0, 2)
+
+	if !reflect.DeepEqual(tracer.Events(), sampleTrace.Events) {
+		t.Errorf("Events() = %v; want %v", tracer.Events(), sampleTrace.Events)
+	}
+
+	var none *Tracer
+	none.Compare(0, 1)
+	none.Write(0, 1)
+	if none.Events() != nil {
+		t.Errorf("nil Tracer recorded %v", none.Events())
+	}
+}
+
+// TestRecordWrite tests that generic writes are recorded for ints only
+func TestRecordWrite(t *testing.T) {
+	tracer := NewTracer()
+	RecordWrite(tracer, 1, 7)
+	RecordWrite(tracer, 2, "seven")
+	RecordWrite[int](nil, 0, 1)
+
+	expected := []TraceEvent{{Kind: TraceWrite, I: 1, Value: 7}}
+	if !slices.Equal(tracer.Events(), expected) {
+		t.Errorf("Events() = %v; want %v", tracer.Events(), expected)
+	}
+}
+
+// TestTraceEventKindText tests that kinds are encoded by name and unknown names are rejected
+func TestTraceEventKindText(t *testing.T) {
+	for _, kind := range TraceEventKinds() {
+		text, err := kind.MarshalText()
+		if err != nil {
+			t.Fatalf("MarshalText(%d) error = %v", kind, err)
+		}
+
+		var decoded TraceEventKind
+		if err := decoded.UnmarshalText(text); err != nil || decoded != kind {
+			t.Errorf("UnmarshalText(%q) = %v, %v; want %v", text, decoded, err, kind)
+		}
+	}
+
+	var kind TraceEventKind
+	if err := kind.UnmarshalText([]byte("shuffle")); err == nil {
+		t.Error(`UnmarshalText("shuffle") succeeded; want an error`)
+	}
+	if _, err := TraceEventKind(42).MarshalText(); err == nil {
+		t.Error("MarshalText(42) succeeded; want an error")
+	}
+}
+
+// TestWriteReadTrace tests that both formats round-trip and are detected when read back
+func TestWriteReadTrace(t *testing.T) {
+	empty := Trace{Algorithm: "empty", Input: []int{}}
+
+	tests := []struct {
+		name   string
+		format TraceFormat
+		trace  Trace
+	}{
+		{name: "JSON lines", format: TraceJSONLines, trace: sampleTrace},
+		{name: "Binary", format: TraceBinary, trace: sampleTrace},
+		{name: "JSON lines without events", format: TraceJSONLines, trace: empty},
+		{name: "Binary without events", format: TraceBinary, trace: empty},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := WriteTrace(&buf, tt.format, tt.trace); err != nil {
+				t.Fatalf("WriteTrace() error = %v", err)
+			}
+
+			got, err := ReadTrace(&buf)
+			if err != nil {
+				t.Fatalf("ReadTrace() error = %v", err)
+			}
+			if got.Algorithm != tt.trace.Algorithm || !slices.Equal(got.Input, tt.trace.Input) || !slices.Equal(got.Events, tt.trace.Events) {
+				t.Errorf("ReadTrace() = %+v; want %+v", got, tt.trace)
+			}
+		})
+	}
+}
+
+// TestWriteTraceJSONLines tests the layout of a JSON-lines trace
+func TestWriteTraceJSONLines(t *testing.T) {
+	trace := Trace{
+		Algorithm: "bubble",
+		Input:     []int{2, 1},
+		Events:    []TraceEvent{{Kind: TraceCompare, I: 0, J: 1}, {Kind: TraceSwap, I: 0, J: 1}},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteTrace(&buf, TraceJSONLines, trace); err != nil {
+		t.Fatalf("WriteTrace() error = %v", err)
+	}
+
+	expected := `{"version":1,"algorithm":"bubble","input":[2,1]}
+{"e":"compare","j":1}
+{"e":"swap","j":1}
+`
+	if buf.String() != expected {
+		t.Errorf("WriteTrace() =\n%s\nwant\n%s", buf.String(), expected)
+	}
+}
+
+// TestBinaryTraceIsCompact tests that the binary format is smaller than JSON lines
+func TestBinaryTraceIsCompact(t *testing.T) {
+	var jsonLines, binary bytes.Buffer
+	if err := WriteTrace(&jsonLines, TraceJSONLines, sampleTrace); err != nil {
+		t.Fatal(err)
+	}
+	if err := WriteTrace(&binary, TraceBinary, sampleTrace); err != nil {
+		t.Fatal(err)
+	}
+
+	if binary.Len() >= jsonLines.Len()/3 {
+		t.Errorf("binary trace is %d bytes; want under a third of the %d bytes of JSON lines", binary.Len(), jsonLines.Len())
+	}
+}
+
+// TestReadTraceErrors tests that malformed traces are rejected
+func TestReadTraceErrors(t *testing.T) {
+	var binary bytes.Buffer
+	if err := WriteTrace(&binary, TraceBinary, sampleTrace); err != nil {
+		t.Fatal(err)
+	}
+	encoded := binary.String()
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "Empty", input: ""},
+		{name: "Not JSON", input: "hello\n"},
+		{name: "Unsupported JSON version", input: `{"version":2,"algorithm":"x","input":[]}` + "\n"},
+		{name: "Unknown JSON event", input: `{"version":1,"algorithm":"x","input":[1]}` + "\n" + `{"e":"shuffle"}` + "\n"},
+		{name: "Unsupported binary version", input: traceMagic + "\x02"},
+		{name: "Truncated binary header", input: encoded[:len(traceMagic)+3]},
+		{name: "Truncated binary event", input: encoded[:len(encoded)-1]},
+		{name: "Unknown binary event", input: encoded + "\x42\x00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if trace, err := ReadTrace(strings.NewReader(tt.input)); err == nil {
+				t.Errorf("ReadTrace(%q) = %+v; want an error", tt.input, trace)
+			}
+		})
+	}
+}
+
+// TestReplayTrace tests that swaps and writes are applied in order and bad indices are reported
+func TestReplayTrace(t *testing.T) {
+	var states [][]int
+	result, err := ReplayTrace(sampleTrace, func(state []int, event TraceEvent) {
+		states = append(states, slices.Clone(state))
+	})
+	if err != nil {
+		t.Fatalf("ReplayTrace() error = %v", err)
+	}
+
+	if !slices.Equal(result, []int{-1, 2, 3}) {
+		t.Errorf("ReplayTrace() = %v; want [-1 2 3]", result)
+	}
+	if len(states) != len(sampleTrace.Events) || !slices.Equal(states[3], []int{-1, 3, 2}) {
+		t.Errorf("states = %v; want one per event with [-1 3 2] after the swap", states)
+	}
+	if !slices.Equal(sampleTrace.Input, []int{3, -1, 2}) {
+		t.Errorf("ReplayTrace modified the input to %v", sampleTrace.Input)
+	}
+
+	outOfRange := Trace{Input: []int{1, 2}, Events: []TraceEvent{{Kind: TraceSwap, I: 0, J: 2}}}
+	if _, err := ReplayTrace(outOfRange, nil); err == nil {
+		t.Error("ReplayTrace() succeeded on an out-of-range swap; want an error")
+	}
+}
+
+// TestSummarizeTrace tests the event counts, depth and verdict of a summary
+func TestSummarizeTrace(t *testing.T) {
+	summary, err := SummarizeTrace(sampleTrace)
+	if err != nil {
+		t.Fatalf("SummarizeTrace() error = %v", err)
+	}
+
+	expectedCounts := map[TraceEventKind]int{
+		TraceEnter: 1, TracePivot: 1, TraceCompare: 1, TraceSwap: 1,
+		TraceMergeBegin: 1, TraceWrite: 2, TraceMergeEnd: 1, TraceExit: 1,
+	}
+	if !reflect.DeepEqual(summary.Counts, expectedCounts) {
+		t.Errorf("Counts = %v; want %v", summary.Counts, expectedCounts)
+	}
+	if summary.Algorithm != "sample" || summary.Length != 3 || summary.Events != 9 || summary.MaxDepth != 1 || !summary.Sorted {
+		t.Errorf("SummarizeTrace() = %+v", summary)
+	}
+
+	unsorted := Trace{Input: []int{2, 1}}
+	if summary, err := SummarizeTrace(unsorted); err != nil || summary.Sorted {
+		t.Errorf("SummarizeTrace(%v) = %+v, %v; want an unsorted summary", unsorted.Input, summary, err)
+	}
+}
+
+// TestParseTraceFormat tests the accepted format names
+func TestParseTraceFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected TraceFormat
+		wantErr  bool
+	}{
+		{name: "jsonl", expected: TraceJSONLines},
+		{name: "JSON", expected: TraceJSONLines},
+		{name: "binary", expected: TraceBinary},
+		{name: "bin", expected: TraceBinary},
+		{name: "csv", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseTraceFormat(tt.name)
+			if (err != nil) != tt.wantErr || got != tt.expected {
+				t.Errorf("ParseTraceFormat(%q) = %q, %v; want %q", tt.name, got, err, tt.expected)
+			}
+		})
+	}
+}
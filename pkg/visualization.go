@@ -0,0 +1,102 @@
+package pkg
+
+import (
+	"slices"
+	"strings"
+)
+
+// StepCallback is called by the WithCallback sorts after every step with the current state
+// of the array and the two indices the step touched
+type StepCallback = func(arr []int, i, j int)
+
+// StepKind tells what the two indices passed to a StepCallback mean, which depends on the sort
+type StepKind int
+
+const (
+	SwapStep   StepKind = iota // the elements at i and j were exchanged
+	InsertStep                 // the element at j was inserted at i, shifting the elements in between
+	MergeStep                  // the range i..j was merged and is now sorted
+)
+
+// VisualizationFrame is a snapshot of an array during a sort
+type VisualizationFrame struct {
+	Values []int
+	Kind   StepKind // what the step did with First and Second
+	First  int      // first index touched by the step, -1 on the input and result frames
+	Second int      // second index touched by the step, -1 on the input and result frames
+}
+
+// Highlighted returns the indices touched by the step, every index of the range for a merge,
+// none for the input and result frames
+func (f VisualizationFrame) Highlighted() []int {
+	if f.First < 0 {
+		return nil
+	}
+	if f.Kind == MergeStep {
+		indices := make([]int, 0, f.Second-f.First+1)
+		for i := f.First; i <= f.Second; i++ {
+			indices = append(indices, i)
+		}
+		return indices
+	}
+	return []int{f.First, f.Second}
+}
+
+// RecordFrames runs sort on a copy of input and returns one frame for the input,
+// one of the given kind for every callback and one for the sorted result
+func RecordFrames(sort func(arr []int, callback StepCallback) []int, kind StepKind, input []int) []VisualizationFrame {
+	frames := []VisualizationFrame{{Values: slices.Clone(input), First: -1, Second: -1}}
+
+	sorted := sort(slices.Clone(input), func(arr []int, i, j int) {
+		frames = append(frames, VisualizationFrame{Values: slices.Clone(arr), Kind: kind, First: i, Second: j})
+	})
+
+	return append(frames, VisualizationFrame{Values: slices.Clone(sorted), First: -1, Second: -1})
+}
+
+// RenderBars draws values as a vertical ASCII bar chart of the given height, one column per value
+// Bars are scaled between the smallest and the largest value, so negatives are drawn too
+// Highlighted columns are drawn with '@' instead of '#' and marked with '^' under the chart
+func RenderBars(values []int, height int, highlighted ...int) string {
+	if len(values) == 0 || height < 1 {
+		return ""
+	}
+
+	low, high := slices.Min(values), slices.Max(values)
+	bars := make([]int, len(values))
+	for i, value := range values {
+		bars[i] = height
+		if high > low {
+			bars[i] = 1 + (value-low)*(height-1)/(high-low)
+		}
+	}
+
+	var sb strings.Builder
+	line := make([]byte, 2*len(values))
+	for row := height; row >= 1; row-- {
+		for i := range values {
+			line[2*i], line[2*i+1] = ' ', ' '
+			if bars[i] >= row {
+				line[2*i] = '#'
+				if slices.Contains(highlighted, i) {
+					line[2*i] = '@'
+				}
+			}
+		}
+		sb.WriteString(strings.TrimRight(string(line), " "))
+		sb.WriteByte('\n')
+	}
+
+	for i := range line {
+		line[i] = ' '
+	}
+	for _, i := range highlighted {
+		if i >= 0 && i < len(values) {
+			line[2*i] = '^'
+		}
+	}
+	sb.WriteString(strings.TrimRight(string(line), " "))
+	sb.WriteByte('\n')
+
+	return sb.String()
+}
@@ -0,0 +1,114 @@
+package pkg
+
+import (
+	"slices"
+	"testing"
+)
+
+// TestRenderBars tests scaling, highlighting and the marker line of the bar chart
+func TestRenderBars(t *testing.T) {
+	tests := []struct {
+		name        string
+		values      []int
+		height      int
+		highlighted []int
+		expected    string
+	}{
+		{
+			name:     "Empty",
+			values:   []int{},
+			height:   3,
+			expected: "",
+		},
+		{
+			name:     "Scaled between min and max",
+			values:   []int{3, 1, 2},
+			height:   3,
+			expected: "#\n#   #\n# # #\n\n",
+		},
+		{
+			name:        "Highlighted columns",
+			values:      []int{3, 1, 2},
+			height:      3,
+			highlighted: []int{1, 2},
+			expected:    "#\n#   @\n# @ @\n  ^ ^\n",
+		},
+		{
+			name:     "Negative values",
+			values:   []int{-5, 5},
+			height:   2,
+			expected: "  #\n# #\n\n",
+		},
+		{
+			name:     "All equal",
+			values:   []int{7, 7},
+			height:   2,
+			expected: "# #\n# #\n\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RenderBars(tt.values, tt.height, tt.highlighted...); got != tt.expected {
+				t.Errorf("RenderBars(%v, %d, %v) =\n%q\nwant\n%q", tt.values, tt.height, tt.highlighted, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestRecordFrames tests that the frames start with the input, snapshot every step and end with the result
+func TestRecordFrames(t *testing.T) {
+	input := []int{2, 1, 3}
+
+	// A single swap of the first two elements
+	sort := func(arr []int, callback StepCallback) []int {
+		arr[0], arr[1] = arr[1], arr[0]
+		callback(arr, 0, 1)
+		return arr
+	}
+
+	frames := RecordFrames(sort, SwapStep, input)
+	expected := []VisualizationFrame{
+		{Values: []int{2, 1, 3}, First: -1, Second: -1},
+		{Values: []int{1, 2, 3}, Kind: SwapStep, First: 0, Second: 1},
+		{Values: []int{1, 2, 3}, First: -1, Second: -1},
+	}
+
+	if len(frames) != len(expected) {
+		t.Fatalf("RecordFrames() returned %d frames; want %d", len(frames), len(expected))
+	}
+	for i := range frames {
+		if !slices.Equal(frames[i].Values, expected[i].Values) || frames[i].Kind != expected[i].Kind ||
+			frames[i].First != expected[i].First || frames[i].Second != expected[i].Second {
+			t.Errorf("frame %d = %+v; want %+v", i, frames[i], expected[i])
+		}
+	}
+	if !slices.Equal(input, []int{2, 1, 3}) {
+		t.Errorf("RecordFrames modified its input to %v", input)
+	}
+	if frames[0].Highlighted() != nil || !slices.Equal(frames[1].Highlighted(), []int{0, 1}) {
+		t.Errorf("Highlighted() = %v, %v; want [] and [0 1]", frames[0].Highlighted(), frames[1].Highlighted())
+	}
+}
+
+// TestHighlighted tests that swaps and insertions highlight their two indices and merges their whole range
+func TestHighlighted(t *testing.T) {
+	tests := []struct {
+		name     string
+		frame    VisualizationFrame
+		expected []int
+	}{
+		{name: "Input", frame: VisualizationFrame{First: -1, Second: -1}, expected: nil},
+		{name: "Swap", frame: VisualizationFrame{Kind: SwapStep, First: 3, Second: 1}, expected: []int{3, 1}},
+		{name: "Insert", frame: VisualizationFrame{Kind: InsertStep, First: 0, Second: 2}, expected: []int{0, 2}},
+		{name: "Merge", frame: VisualizationFrame{Kind: MergeStep, First: 2, Second: 5}, expected: []int{2, 3, 4, 5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.frame.Highlighted(); !slices.Equal(got, tt.expected) {
+				t.Errorf("Highlighted() = %v; want %v", got, tt.expected)
+			}
+		})
+	}
+}
@@ -1,71 +1,126 @@
 package bubble_sort
 
+import (
+	"cmp"
+	"slices"
+
+	"github.com/JoaoVitor615/algorithms-in-go/pkg"
+)
+
 // BubbleSort sorts an array using the Bubble Sort algorithm
 // Time Complexity: O(n²) worst and average case, O(n) best case (optimized version)
 // Space Complexity: O(1)
 // (Currently not used in the project)
 func BubbleSort(arr []int) []int {
+	return BubbleSortOrdered(arr)
+}
+
+// BubbleSortOptimized sorts an array using an optimized Bubble Sort algorithm
+// This version stops early if no swaps are made in a pass (array is already sorted)
+// Time Complexity: O(n²) worst case, O(n) best case when array is already sorted
+// Space Complexity: O(1)
+func BubbleSortOptimized(arr []int) []int {
+	return BubbleSortOptimizedOrdered(arr)
+}
+
+// BubbleSortOptimizedInstrumented sorts an array like BubbleSortOptimized and records
+// the comparisons, swaps and allocations it performs in counter
+func BubbleSortOptimizedInstrumented(arr []int, counter *pkg.OperationCounter) []int {
 	if len(arr) <= 1 {
-		return arr
+		return slices.Clone(arr)
 	}
 
-	// Make a copy to avoid modifying the original array
 	result := make([]int, len(arr))
 	copy(result, arr)
+	counter.Allocate(len(result))
 
-	n := len(result)
+	bubbleSortOptimized(result, pkg.CountComparisons(counter, cmp.Compare[int]), counter, nil)
+	return result
+}
 
-	// Perform bubble sort
-	for i := 0; i < n-1; i++ {
-		for j := 0; j < n-i-1; j++ {
-			if result[j] > result[j+1] {
-				// Swap elements
-				result[j], result[j+1] = result[j+1], result[j]
-			}
+// BubbleSortOptimizedTraced sorts an array like BubbleSortOptimized and records every
+// comparison and swap it performs, with the indices involved, in tracer
+func BubbleSortOptimizedTraced(arr []int, tracer *pkg.Tracer) []int {
+	result := slices.Clone(arr)
+	bubbleSortOptimized(result, cmp.Compare[int], nil, tracer)
+	return result
+}
+
+// BubbleSortInPlace sorts an array in-place using the Bubble Sort algorithm
+func BubbleSortInPlace(arr []int) {
+	BubbleSortInPlaceOrdered(arr)
+}
+
+// NewSorter returns a pkg.Sorter that runs BubbleSortOptimized and records the operations it performs in counter
+// counter may be nil when the caller does not need operation counts
+func NewSorter(counter *pkg.OperationCounter) pkg.Sorter {
+	return pkg.SorterFunc(func(arr []int) {
+		if len(arr) <= 1 {
+			return
 		}
-	}
+		bubbleSortOptimized(arr, pkg.CountComparisons(counter, cmp.Compare[int]), counter, nil)
+	})
+}
 
-	return result
+// BubbleSortInPlaceOptimized sorts an array in-place using optimized Bubble Sort
+func BubbleSortInPlaceOptimized(arr []int) {
+	BubbleSortInPlaceOptimizedOrdered(arr)
 }
 
-// BubbleSortOptimized sorts an array using an optimized Bubble Sort algorithm
-// This version stops early if no swaps are made in a pass (array is already sorted)
-// Time Complexity: O(n²) worst case, O(n) best case when array is already sorted
-// Space Complexity: O(1)
-func BubbleSortOptimized(arr []int) []int {
+// BubbleSortWithCallback sorts an array and calls a callback function after each swap
+// This is useful for visualization or educational purposes
+func BubbleSortWithCallback(arr []int, callback func([]int, int, int)) []int {
+	return BubbleSortWithCallbackOrdered(arr, callback)
+}
+
+// BubbleSortOrdered sorts a slice of any ordered type (integers, floats, strings)
+// It returns a sorted copy and leaves the original slice untouched
+func BubbleSortOrdered[T cmp.Ordered](arr []T) []T {
+	return BubbleSortFunc(arr, cmp.Compare[T])
+}
+
+// BubbleSortFunc sorts a slice of any type using a comparator function
+// The comparator must return a negative number when a < b, zero when a == b
+// and a positive number when a > b, matching the contract of cmp.Compare
+func BubbleSortFunc[T any](arr []T, compare func(a, b T) int) []T {
 	if len(arr) <= 1 {
-		return arr
+		return slices.Clone(arr)
 	}
 
 	// Make a copy to avoid modifying the original array
-	result := make([]int, len(arr))
+	result := make([]T, len(arr))
 	copy(result, arr)
 
-	n := len(result)
-
-	// Perform optimized bubble sort
-	for i := 0; i < n-1; i++ {
-		swapped := false
+	BubbleSortInPlaceFunc(result, compare)
+	return result
+}
 
-		for j := 0; j < n-i-1; j++ {
-			if result[j] > result[j+1] {
-				// Swap elements
-				result[j], result[j+1] = result[j+1], result[j]
-				swapped = true
-			}
-		}
+// BubbleSortOptimizedOrdered sorts a slice of any ordered type using optimized Bubble Sort
+func BubbleSortOptimizedOrdered[T cmp.Ordered](arr []T) []T {
+	return BubbleSortOptimizedFunc(arr, cmp.Compare[T])
+}
 
-		// If no swapping occurred, array is sorted
-		if !swapped {
-			break
-		}
+// BubbleSortOptimizedFunc sorts a slice using optimized Bubble Sort and a comparator function
+func BubbleSortOptimizedFunc[T any](arr []T, compare func(a, b T) int) []T {
+	if len(arr) <= 1 {
+		return slices.Clone(arr)
 	}
 
+	// Make a copy to avoid modifying the original array
+	result := make([]T, len(arr))
+	copy(result, arr)
+
+	BubbleSortInPlaceOptimizedFunc(result, compare)
 	return result
 }
 
-// BubbleSortInPlace sorts an array in-place using the Bubble Sort algorithm
-func BubbleSortInPlace(arr []int) {
+// BubbleSortInPlaceOrdered sorts a slice of any ordered type in-place
+func BubbleSortInPlaceOrdered[T cmp.Ordered](arr []T) {
+	BubbleSortInPlaceFunc(arr, cmp.Compare[T])
+}
+
+// BubbleSortInPlaceFunc sorts a slice in-place using a comparator function
+func BubbleSortInPlaceFunc[T any](arr []T, compare func(a, b T) int) {
 	if len(arr) <= 1 {
 		return
 	}
@@ -75,7 +130,7 @@ func BubbleSortInPlace(arr []int) {
 	// Perform bubble sort in-place
 	for i := 0; i < n-1; i++ {
 		for j := 0; j < n-i-1; j++ {
-			if arr[j] > arr[j+1] {
+			if compare(arr[j], arr[j+1]) > 0 {
 				// Swap elements
 				arr[j], arr[j+1] = arr[j+1], arr[j]
 			}
@@ -83,8 +138,19 @@ func BubbleSortInPlace(arr []int) {
 	}
 }
 
-// BubbleSortInPlaceOptimized sorts an array in-place using optimized Bubble Sort
-func BubbleSortInPlaceOptimized(arr []int) {
+// BubbleSortInPlaceOptimizedOrdered sorts a slice of any ordered type in-place using optimized Bubble Sort
+func BubbleSortInPlaceOptimizedOrdered[T cmp.Ordered](arr []T) {
+	BubbleSortInPlaceOptimizedFunc(arr, cmp.Compare[T])
+}
+
+// BubbleSortInPlaceOptimizedFunc sorts a slice in-place using optimized Bubble Sort and a comparator function
+func BubbleSortInPlaceOptimizedFunc[T any](arr []T, compare func(a, b T) int) {
+	bubbleSortOptimized(arr, compare, nil, nil)
+}
+
+// bubbleSortOptimized performs the early-exit Bubble Sort in-place
+// counter and tracer may be nil when the caller does not need operation counts or a trace
+func bubbleSortOptimized[T any](arr []T, compare func(a, b T) int, counter *pkg.OperationCounter, tracer *pkg.Tracer) {
 	if len(arr) <= 1 {
 		return
 	}
@@ -96,9 +162,12 @@ func BubbleSortInPlaceOptimized(arr []int) {
 		swapped := false
 
 		for j := 0; j < n-i-1; j++ {
-			if arr[j] > arr[j+1] {
+			tracer.Compare(j, j+1)
+			if compare(arr[j], arr[j+1]) > 0 {
 				// Swap elements
 				arr[j], arr[j+1] = arr[j+1], arr[j]
+				counter.Swap()
+				tracer.Swap(j, j+1)
 				swapped = true
 			}
 		}
@@ -110,15 +179,19 @@ func BubbleSortInPlaceOptimized(arr []int) {
 	}
 }
 
-// BubbleSortWithCallback sorts an array and calls a callback function after each swap
-// This is useful for visualization or educational purposes
-func BubbleSortWithCallback(arr []int, callback func([]int, int, int)) []int {
+// BubbleSortWithCallbackOrdered sorts a slice of any ordered type and calls a callback after each swap
+func BubbleSortWithCallbackOrdered[T cmp.Ordered](arr []T, callback func([]T, int, int)) []T {
+	return BubbleSortWithCallbackFunc(arr, cmp.Compare[T], callback)
+}
+
+// BubbleSortWithCallbackFunc sorts a slice using a comparator function and calls a callback after each swap
+func BubbleSortWithCallbackFunc[T any](arr []T, compare func(a, b T) int, callback func([]T, int, int)) []T {
 	if len(arr) <= 1 {
-		return arr
+		return slices.Clone(arr)
 	}
 
 	// Make a copy to avoid modifying the original array
-	result := make([]int, len(arr))
+	result := make([]T, len(arr))
 	copy(result, arr)
 
 	n := len(result)
@@ -128,7 +201,7 @@ func BubbleSortWithCallback(arr []int, callback func([]int, int, int)) []int {
 		swapped := false
 
 		for j := 0; j < n-i-1; j++ {
-			if result[j] > result[j+1] {
+			if compare(result[j], result[j+1]) > 0 {
 				// Swap elements
 				result[j], result[j+1] = result[j+1], result[j]
 				swapped = true
@@ -1,73 +1,76 @@
 package bubble_sort
 
 import (
+	"cmp"
 	"fmt"
 	"reflect"
 	"testing"
+
+	"github.com/JoaoVitor615/algorithms-in-go/pkg"
 )
 
+// bubbleSortTestCases is the shared table used by the int and generic BubbleSort tests.
+var bubbleSortTestCases = []struct {
+	name     string
+	input    []int
+	expected []int
+}{
+	{
+		name:     "Empty array",
+		input:    []int{},
+		expected: []int{},
+	},
+	{
+		name:     "Single element",
+		input:    []int{5},
+		expected: []int{5},
+	},
+	{
+		name:     "Already sorted array",
+		input:    []int{1, 2, 3, 4, 5},
+		expected: []int{1, 2, 3, 4, 5},
+	},
+	{
+		name:     "Reverse sorted array",
+		input:    []int{5, 4, 3, 2, 1},
+		expected: []int{1, 2, 3, 4, 5},
+	},
+	{
+		name:     "Unsorted array with even number of elements",
+		input:    []int{4, 2, 5, 1, 3, 6},
+		expected: []int{1, 2, 3, 4, 5, 6},
+	},
+	{
+		name:     "Unsorted array with odd number of elements",
+		input:    []int{4, 2, 5, 1, 3},
+		expected: []int{1, 2, 3, 4, 5},
+	},
+	{
+		name:     "Array with duplicate elements",
+		input:    []int{4, 2, 5, 1, 3, 2, 4},
+		expected: []int{1, 2, 2, 3, 4, 4, 5},
+	},
+	{
+		name:     "Array with all same elements",
+		input:    []int{3, 3, 3, 3, 3},
+		expected: []int{3, 3, 3, 3, 3},
+	},
+	{
+		name:     "Array with negative numbers",
+		input:    []int{-5, 2, -3, 8, 1, -1},
+		expected: []int{-5, -3, -1, 1, 2, 8},
+	},
+	{
+		name:     "Large random array",
+		input:    []int{64, 34, 25, 12, 22, 11, 90, 88, 76, 50, 42},
+		expected: []int{11, 12, 22, 25, 34, 42, 50, 64, 76, 88, 90},
+	},
+}
+
 // TestBubbleSort runs unit tests for the BubbleSort function.
 func TestBubbleSort(t *testing.T) {
-	// Define a series of test cases.
-	testCases := []struct {
-		name     string
-		input    []int
-		expected []int
-	}{
-		{
-			name:     "Empty array",
-			input:    []int{},
-			expected: []int{},
-		},
-		{
-			name:     "Single element",
-			input:    []int{5},
-			expected: []int{5},
-		},
-		{
-			name:     "Already sorted array",
-			input:    []int{1, 2, 3, 4, 5},
-			expected: []int{1, 2, 3, 4, 5},
-		},
-		{
-			name:     "Reverse sorted array",
-			input:    []int{5, 4, 3, 2, 1},
-			expected: []int{1, 2, 3, 4, 5},
-		},
-		{
-			name:     "Unsorted array with even number of elements",
-			input:    []int{4, 2, 5, 1, 3, 6},
-			expected: []int{1, 2, 3, 4, 5, 6},
-		},
-		{
-			name:     "Unsorted array with odd number of elements",
-			input:    []int{4, 2, 5, 1, 3},
-			expected: []int{1, 2, 3, 4, 5},
-		},
-		{
-			name:     "Array with duplicate elements",
-			input:    []int{4, 2, 5, 1, 3, 2, 4},
-			expected: []int{1, 2, 2, 3, 4, 4, 5},
-		},
-		{
-			name:     "Array with all same elements",
-			input:    []int{3, 3, 3, 3, 3},
-			expected: []int{3, 3, 3, 3, 3},
-		},
-		{
-			name:     "Array with negative numbers",
-			input:    []int{-5, 2, -3, 8, 1, -1},
-			expected: []int{-5, -3, -1, 1, 2, 8},
-		},
-		{
-			name:     "Large random array",
-			input:    []int{64, 34, 25, 12, 22, 11, 90, 88, 76, 50, 42},
-			expected: []int{11, 12, 22, 25, 34, 42, 50, 64, 76, 88, 90},
-		},
-	}
-
 	// Iterate through each test case.
-	for _, tc := range testCases {
+	for _, tc := range bubbleSortTestCases {
 		// Run the test in a subtest for clear output.
 		t.Run(tc.name, func(t *testing.T) {
 			// Make a copy of input to ensure original isn't modified
@@ -281,22 +284,206 @@ func TestBubbleSortWithCallback(t *testing.T) {
 	}
 }
 
-// TestBubbleSortStability tests that BubbleSort is stable
+// TestBubbleSortOrdered re-runs the BubbleSort table against the generic ordered version
+func TestBubbleSortOrdered(t *testing.T) {
+	for _, tc := range bubbleSortTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			originalInput := make([]int, len(tc.input))
+			copy(originalInput, tc.input)
+
+			result := BubbleSortOrdered(tc.input)
+
+			if !reflect.DeepEqual(tc.input, originalInput) {
+				t.Errorf("BubbleSortOrdered modified the original input array")
+			}
+
+			if !reflect.DeepEqual(result, tc.expected) {
+				t.Errorf("BubbleSortOrdered(%v) = %v; want %v", tc.input, result, tc.expected)
+			}
+		})
+	}
+}
+
+// TestBubbleSortFunc re-runs the BubbleSort table against the comparator version
+func TestBubbleSortFunc(t *testing.T) {
+	for _, tc := range bubbleSortTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			originalInput := make([]int, len(tc.input))
+			copy(originalInput, tc.input)
+
+			result := BubbleSortFunc(tc.input, cmp.Compare[int])
+
+			if !reflect.DeepEqual(tc.input, originalInput) {
+				t.Errorf("BubbleSortFunc modified the original input array")
+			}
+
+			if !reflect.DeepEqual(result, tc.expected) {
+				t.Errorf("BubbleSortFunc(%v) = %v; want %v", tc.input, result, tc.expected)
+			}
+		})
+	}
+}
+
+// TestBubbleSortInPlaceFunc re-runs the BubbleSort table against the in-place comparator version
+func TestBubbleSortInPlaceFunc(t *testing.T) {
+	for _, tc := range bubbleSortTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			input := make([]int, len(tc.input))
+			copy(input, tc.input)
+
+			BubbleSortInPlaceFunc(input, cmp.Compare[int])
+
+			if !reflect.DeepEqual(input, tc.expected) {
+				t.Errorf("BubbleSortInPlaceFunc modified array to %v; want %v", input, tc.expected)
+			}
+		})
+	}
+}
+
+// TestBubbleSortGenericTypes tests the generic versions with non-int element types
+func TestBubbleSortGenericTypes(t *testing.T) {
+	t.Run("Strings", func(t *testing.T) {
+		input := []string{"pear", "apple", "fig", "banana"}
+		expected := []string{"apple", "banana", "fig", "pear"}
+
+		result := BubbleSortOptimizedOrdered(input)
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("BubbleSortOptimizedOrdered(%v) = %v; want %v", input, result, expected)
+		}
+	})
+
+	t.Run("Floats", func(t *testing.T) {
+		input := []float64{3.5, -1.25, 2, 0, 2}
+		expected := []float64{-1.25, 0, 2, 2, 3.5}
+
+		BubbleSortInPlaceOptimizedOrdered(input)
+		if !reflect.DeepEqual(input, expected) {
+			t.Errorf("BubbleSortInPlaceOptimizedOrdered = %v; want %v", input, expected)
+		}
+	})
+
+	t.Run("Structs keep order of equal keys", func(t *testing.T) {
+		type record struct {
+			key   int
+			label string
+		}
+		input := []record{{3, "a"}, {1, "b"}, {3, "c"}, {2, "d"}, {3, "e"}}
+		expected := []record{{1, "b"}, {2, "d"}, {3, "a"}, {3, "c"}, {3, "e"}}
+
+		result := BubbleSortOptimizedFunc(input, func(a, b record) int {
+			return cmp.Compare(a.key, b.key)
+		})
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("BubbleSortOptimizedFunc(%v) = %v; want %v", input, result, expected)
+		}
+	})
+
+	t.Run("Callback with strings", func(t *testing.T) {
+		input := []string{"c", "a", "b"}
+		swaps := 0
+
+		result := BubbleSortWithCallbackOrdered(input, func(arr []string, i, j int) {
+			swaps++
+		})
+		if !reflect.DeepEqual(result, []string{"a", "b", "c"}) || swaps == 0 {
+			t.Errorf("BubbleSortWithCallbackOrdered(%v) = %v with %d swaps", input, result, swaps)
+		}
+	})
+}
+
+// TestBubbleSortOptimizedInstrumented tests the operation counts of the instrumented version
+func TestBubbleSortOptimizedInstrumented(t *testing.T) {
+	testCases := []struct {
+		name        string
+		input       []int
+		comparisons int64
+		swaps       int64
+	}{
+		{
+			name:        "Already sorted array stops after one pass",
+			input:       []int{1, 2, 3, 4, 5},
+			comparisons: 4,
+			swaps:       0,
+		},
+		{
+			name:        "Reverse sorted array swaps every pair",
+			input:       []int{5, 4, 3, 2, 1},
+			comparisons: 10,
+			swaps:       10,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			counter := pkg.NewOperationCounter()
+			result := BubbleSortOptimizedInstrumented(tc.input, counter)
+
+			if !reflect.DeepEqual(result, BubbleSortOptimized(tc.input)) {
+				t.Errorf("BubbleSortOptimizedInstrumented(%v) = %v; want sorted output", tc.input, result)
+			}
+			if counter.Comparisons != tc.comparisons || counter.Swaps != tc.swaps {
+				t.Errorf("counted %d comparisons and %d swaps; want %d and %d",
+					counter.Comparisons, counter.Swaps, tc.comparisons, tc.swaps)
+			}
+			if counter.Writes != 2*tc.swaps || counter.MaxDepth != 0 {
+				t.Errorf("counted %d writes at depth %d; want %d writes at depth 0", counter.Writes, counter.MaxDepth, 2*tc.swaps)
+			}
+		})
+	}
+}
+
+// TestBubbleSortOptimizedTraced tests that the trace replays to the sorted result and agrees with the operation counts
+func TestBubbleSortOptimizedTraced(t *testing.T) {
+	for _, tc := range bubbleSortTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			counter := pkg.NewOperationCounter()
+			expected := BubbleSortOptimizedInstrumented(tc.input, counter)
+
+			if result := BubbleSortOptimizedTraced(tc.input, nil); !reflect.DeepEqual(result, expected) {
+				t.Errorf("BubbleSortOptimizedTraced(%v, nil) = %v; want %v", tc.input, result, expected)
+			}
+
+			summary, err := pkg.SummarizeTrace(pkg.RecordTrace("bubble", BubbleSortOptimizedTraced, tc.input))
+			if err != nil {
+				t.Fatalf("SummarizeTrace() error = %v", err)
+			}
+			if !reflect.DeepEqual(summary.Result, expected) || !summary.Sorted {
+				t.Errorf("replayed trace ends with %v; want %v", summary.Result, expected)
+			}
+			if summary.Counts[pkg.TraceCompare] != int(counter.Comparisons) || summary.Counts[pkg.TraceSwap] != int(counter.Swaps) {
+				t.Errorf("traced %d comparisons and %d swaps; counted %d and %d",
+					summary.Counts[pkg.TraceCompare], summary.Counts[pkg.TraceSwap], counter.Comparisons, counter.Swaps)
+			}
+		})
+	}
+}
+
+// TestBubbleSortStability tests that every comparator variant keeps records with equal keys
+// in input order, using the tagged records of the pkg stability harness
 func TestBubbleSortStability(t *testing.T) {
-	// For testing stability, we need a way to distinguish between equal elements
-	// Since we're working with integers, we'll test the behavior with duplicates
-	input := []int{3, 1, 3, 2, 3}
-	expected := []int{1, 2, 3, 3, 3}
+	cases := pkg.StabilityCases(pkg.NewRandomGeneratorWithSeed(1), 200)
 
-	result := BubbleSort(input)
-	if !reflect.DeepEqual(result, expected) {
-		t.Errorf("BubbleSort stability test failed: got %v, want %v", result, expected)
+	sorts := map[string]func([]pkg.Record) []pkg.Record{
+		"BubbleSortFunc": func(records []pkg.Record) []pkg.Record {
+			return BubbleSortFunc(records, pkg.CompareRecords)
+		},
+		"BubbleSortOptimizedFunc": func(records []pkg.Record) []pkg.Record {
+			return BubbleSortOptimizedFunc(records, pkg.CompareRecords)
+		},
+		"BubbleSortInPlaceFunc": func(records []pkg.Record) []pkg.Record {
+			BubbleSortInPlaceFunc(records, pkg.CompareRecords)
+			return records
+		},
+		"BubbleSortInPlaceOptimizedFunc": func(records []pkg.Record) []pkg.Record {
+			BubbleSortInPlaceOptimizedFunc(records, pkg.CompareRecords)
+			return records
+		},
 	}
 
-	// Test with optimized version
-	result2 := BubbleSortOptimized(input)
-	if !reflect.DeepEqual(result2, expected) {
-		t.Errorf("BubbleSortOptimized stability test failed: got %v, want %v", result2, expected)
+	for name, sort := range sorts {
+		if report := pkg.VerifyStability(sort, cases); !report.Stable() {
+			t.Errorf("%s: %s", name, report)
+		}
 	}
 }
 
@@ -399,3 +586,45 @@ func BenchmarkBubbleSortInPlace(b *testing.B) {
 	}
 }
 
+
+// TestBubbleSortReturnsCopy tests that the copying variants never hand back the caller's slice,
+// even for inputs short enough to need no sorting
+func TestBubbleSortReturnsCopy(t *testing.T) {
+	sorts := map[string]func([]int) []int{
+		"BubbleSort":          BubbleSort,
+		"BubbleSortOptimized": BubbleSortOptimized,
+	}
+
+	for name, sort := range sorts {
+		for _, input := range [][]int{{5}, {2, 1}} {
+			sorted := sort(input)
+			sorted[0] = -1
+			if input[0] == -1 {
+				t.Errorf("%s(%d elements) returned a slice sharing memory with its input", name, len(input))
+			}
+		}
+	}
+}
+
+// FuzzBubbleSort checks the sort properties of every Bubble Sort variant on fuzzed inputs
+func FuzzBubbleSort(f *testing.F) {
+	for _, tc := range bubbleSortTestCases {
+		f.Add(pkg.BytesFromInts(tc.input))
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		input := pkg.IntsFromBytes(data)
+		checks := map[string]error{
+			"BubbleSort":                 pkg.CheckSort(BubbleSort, input),
+			"BubbleSortOptimized":        pkg.CheckSort(BubbleSortOptimized, input),
+			"BubbleSortInPlace":          pkg.CheckSortInPlace(BubbleSortInPlace, input),
+			"BubbleSortInPlaceOptimized": pkg.CheckSortInPlace(BubbleSortInPlaceOptimized, input),
+			"NewSorter":                  pkg.CheckSorter(NewSorter(pkg.NewOperationCounter()), input),
+		}
+		for name, err := range checks {
+			if err != nil {
+				t.Errorf("%s: %v", name, err)
+			}
+		}
+	})
+}
@@ -0,0 +1,162 @@
+package bucket_sort
+
+import (
+	"cmp"
+	"math/bits"
+	"slices"
+
+	"github.com/JoaoVitor615/algorithms-in-go/pkg"
+	"github.com/JoaoVitor615/algorithms-in-go/sorting/insertion_sort"
+)
+
+// BucketSort sorts an array by spreading its values over n equal-width buckets between the
+// minimum and the maximum, then sorting each bucket with Insertion Sort
+// Time Complexity: O(n + k) on average for evenly spread values, O(n²) when they all share a bucket
+// Space Complexity: O(n + k), with k buckets
+func BucketSort(arr []int) []int {
+	return BucketSortWithBuckets(arr, len(arr))
+}
+
+// BucketSortInstrumented sorts an array like BucketSort and records the comparisons
+// made inside the buckets, element writes and allocations in counter
+func BucketSortInstrumented(arr []int, counter *pkg.OperationCounter) []int {
+	if len(arr) <= 1 {
+		return slices.Clone(arr)
+	}
+
+	result := make([]int, len(arr))
+	counter.Allocate(len(result))
+
+	bucketSort(arr, result, len(arr), identity, pkg.CountComparisons(counter, cmp.Compare[int]), counter)
+	return result
+}
+
+// BucketSortInPlace sorts an array with Bucket Sort and writes the result back into arr
+// It still needs O(n + k) extra memory for the buckets
+func BucketSortInPlace(arr []int) {
+	if len(arr) <= 1 {
+		return
+	}
+
+	copy(arr, BucketSort(arr))
+}
+
+// NewSorter returns a pkg.Sorter that runs BucketSort and records the comparisons made
+// inside the buckets, element writes and allocations in counter
+// Sort scatters straight into dst, SortInPlace needs an output buffer and copies it back into arr
+// counter may be nil when the caller does not need operation counts
+func NewSorter(counter *pkg.OperationCounter) pkg.Sorter {
+	return sorter{counter: counter}
+}
+
+// sorter implements pkg.Sorter with Bucket Sort, which reads src and writes dst in separate slices
+type sorter struct {
+	counter *pkg.OperationCounter
+}
+
+// Sort writes the elements of src into dst in ascending order
+func (s sorter) Sort(dst, src []int) {
+	dst = pkg.SortDestination(dst, src)
+	if len(src) <= 1 || pkg.SameSlice(dst, src) {
+		copy(dst, src)
+		s.SortInPlace(dst)
+		return
+	}
+
+	bucketSort(src, dst, len(src), identity, pkg.CountComparisons(s.counter, cmp.Compare[int]), s.counter)
+}
+
+// SortInPlace sorts arr through an output buffer
+func (s sorter) SortInPlace(arr []int) {
+	if len(arr) <= 1 {
+		return
+	}
+
+	result := make([]int, len(arr))
+	s.counter.Allocate(len(result))
+
+	bucketSort(arr, result, len(arr), identity, pkg.CountComparisons(s.counter, cmp.Compare[int]), s.counter)
+	copy(arr, result)
+	s.counter.Write(len(arr))
+}
+
+// BucketSortWithBuckets sorts an array using the given number of buckets
+// Fewer buckets save memory but leave more work to Insertion Sort; values below 1 use one bucket
+func BucketSortWithBuckets(arr []int, buckets int) []int {
+	return BucketSortByKey(arr, buckets, identity)
+}
+
+// BucketSortByKey sorts a slice of any type by an integer key using the given number of buckets
+// It returns a sorted copy and leaves the original slice untouched
+// Elements with equal keys keep their original relative order
+func BucketSortByKey[T any](arr []T, buckets int, key func(T) int) []T {
+	if len(arr) <= 1 {
+		return slices.Clone(arr)
+	}
+
+	result := make([]T, len(arr))
+	bucketSort(arr, result, buckets, key, func(a, b T) int {
+		return cmp.Compare(key(a), key(b))
+	}, nil)
+	return result
+}
+
+// bucketSort scatters the elements of src into contiguous buckets of dst, then sorts each bucket
+// The buckets are laid out with a counting pass, so no per-bucket slices are allocated
+// counter may be nil when the caller does not need operation counts
+func bucketSort[T any](src, dst []T, buckets int, key func(T) int, compare func(a, b T) int, counter *pkg.OperationCounter) {
+	buckets = max(buckets, 1)
+
+	low, high := key(src[0]), key(src[0])
+	for _, element := range src[1:] {
+		k := key(element)
+		low = min(low, k)
+		high = max(high, k)
+	}
+
+	// Width of the value range, zero when it spans every int and does not fit in a uint64
+	width := uint64(high-low) + 1
+
+	// bucketOf maps a key to floor((key - low) * buckets / width) without overflowing
+	bucketOf := func(element T) int {
+		hi, lo := bits.Mul64(uint64(key(element)-low), uint64(buckets))
+		if width == 0 {
+			return int(hi)
+		}
+		quotient, _ := bits.Div64(hi, lo, width)
+		return int(quotient)
+	}
+
+	// starts[b] is the first slot of bucket b, starts[buckets] the end of the last one
+	starts := make([]int, buckets+1)
+	counter.Allocate(len(starts))
+
+	for _, element := range src {
+		starts[bucketOf(element)+1]++
+	}
+	for b := 1; b <= buckets; b++ {
+		starts[b] += starts[b-1]
+	}
+
+	next := make([]int, buckets)
+	copy(next, starts)
+	counter.Allocate(len(next))
+
+	for _, element := range src {
+		b := bucketOf(element)
+		dst[next[b]] = element
+		next[b]++
+	}
+	counter.Write(len(src))
+
+	for b := 0; b < buckets; b++ {
+		if starts[b+1]-starts[b] > 1 {
+			insertion_sort.InsertionSortInPlaceWithGapInstrumented(dst[starts[b]:starts[b+1]], 1, compare, counter)
+		}
+	}
+}
+
+// identity is the key of an int sorted by its own value
+func identity(x int) int {
+	return x
+}
@@ -0,0 +1,248 @@
+package bucket_sort
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/JoaoVitor615/algorithms-in-go/pkg"
+)
+
+// bucketSortTestCases is the shared table used by the int and generic BucketSort tests.
+var bucketSortTestCases = []struct {
+	name     string
+	input    []int
+	expected []int
+}{
+	{
+		name:     "Empty array",
+		input:    []int{},
+		expected: []int{},
+	},
+	{
+		name:     "Single element",
+		input:    []int{5},
+		expected: []int{5},
+	},
+	{
+		name:     "Already sorted array",
+		input:    []int{1, 2, 3, 4, 5},
+		expected: []int{1, 2, 3, 4, 5},
+	},
+	{
+		name:     "Reverse sorted array",
+		input:    []int{5, 4, 3, 2, 1},
+		expected: []int{1, 2, 3, 4, 5},
+	},
+	{
+		name:     "Unsorted array with even number of elements",
+		input:    []int{4, 2, 5, 1, 3, 6},
+		expected: []int{1, 2, 3, 4, 5, 6},
+	},
+	{
+		name:     "Array with duplicate elements",
+		input:    []int{4, 2, 5, 1, 3, 2, 4},
+		expected: []int{1, 2, 2, 3, 4, 4, 5},
+	},
+	{
+		name:     "Array with all same elements",
+		input:    []int{3, 3, 3, 3, 3},
+		expected: []int{3, 3, 3, 3, 3},
+	},
+	{
+		name:     "Array with negative numbers",
+		input:    []int{-5, 2, -3, 8, 1, -1},
+		expected: []int{-5, -3, -1, 1, 2, 8},
+	},
+	{
+		name:     "Large random array",
+		input:    []int{64, 34, 25, 12, 22, 11, 90, 88, 76, 50, 42},
+		expected: []int{11, 12, 22, 25, 34, 42, 50, 64, 76, 88, 90},
+	},
+}
+
+// TestBucketSort runs the shared table against every variant
+func TestBucketSort(t *testing.T) {
+	sorts := []struct {
+		name string
+		sort func([]int) []int
+	}{
+		{"BucketSort", BucketSort},
+		{"BucketSortInstrumented", func(arr []int) []int { return BucketSortInstrumented(arr, pkg.NewOperationCounter()) }},
+		{"BucketSortWithBuckets", func(arr []int) []int { return BucketSortWithBuckets(arr, 3) }},
+		{"BucketSortByKey", func(arr []int) []int { return BucketSortByKey(arr, len(arr), identity) }},
+		{"BucketSortInPlace", func(arr []int) []int {
+			result := slices.Clone(arr)
+			BucketSortInPlace(result)
+			return result
+		}},
+	}
+
+	for _, variant := range sorts {
+		for _, tc := range bucketSortTestCases {
+			t.Run(variant.name+"/"+tc.name, func(t *testing.T) {
+				input := slices.Clone(tc.input)
+				result := variant.sort(input)
+
+				if !reflect.DeepEqual(result, tc.expected) {
+					t.Errorf("%s(%v) = %v; want %v", variant.name, tc.input, result, tc.expected)
+				}
+				if variant.name != "BucketSortInPlace" && !reflect.DeepEqual(input, tc.input) {
+					t.Errorf("%s modified its input: got %v, want %v", variant.name, input, tc.input)
+				}
+			})
+		}
+	}
+}
+
+// TestBucketSortDistributions checks BucketSort against slices.Sort with several bucket counts
+func TestBucketSortDistributions(t *testing.T) {
+	generator := pkg.NewRandomGeneratorWithSeed(42)
+
+	for _, distribution := range pkg.Distributions() {
+		input := generator.GenerateDistribution(distribution, 5000)
+		expected := slices.Clone(input)
+		slices.Sort(expected)
+
+		for _, buckets := range []int{-1, 1, 10, 5000, 100000} {
+			t.Run(fmt.Sprintf("%v/%d buckets", distribution, buckets), func(t *testing.T) {
+				if result := BucketSortWithBuckets(input, buckets); !reflect.DeepEqual(result, expected) {
+					t.Errorf("BucketSortWithBuckets did not sort %v numbers with %d buckets", distribution, buckets)
+				}
+			})
+		}
+	}
+}
+
+// TestBucketSortRanges checks that negative and extreme values land in valid buckets
+func TestBucketSortRanges(t *testing.T) {
+	generator := pkg.NewRandomGeneratorWithSeed(42)
+	inputs := map[string][]int{
+		"Negative range": generator.GenerateIntSlice(5000, -1000, 1000),
+		"Wide range":     generator.GenerateIntSlice(5000, math.MinInt32, math.MaxInt32),
+		"Extreme values": {math.MaxInt, math.MinInt, 0, -1, 1, math.MinInt + 1, math.MaxInt - 1, math.MinInt, math.MaxInt},
+		"Near maximum":   {math.MaxInt, math.MaxInt - 3, math.MaxInt - 1, math.MaxInt - 2},
+	}
+
+	for name, input := range inputs {
+		t.Run(name, func(t *testing.T) {
+			expected := slices.Clone(input)
+			slices.Sort(expected)
+
+			if result := BucketSort(input); !reflect.DeepEqual(result, expected) {
+				t.Errorf("BucketSort did not sort %d %s numbers", len(input), name)
+			}
+		})
+	}
+}
+
+// TestBucketSortStability sorts tagged records by key and checks that equal keys keep their order
+func TestBucketSortStability(t *testing.T) {
+	type record struct {
+		key   int
+		index int
+	}
+
+	keys := pkg.NewRandomGeneratorWithSeed(7).GenerateIntSlice(5000, -300, 300)
+	records := make([]record, len(keys))
+	for i, key := range keys {
+		records[i] = record{key, i}
+	}
+
+	expected := slices.Clone(records)
+	slices.SortStableFunc(expected, func(a, b record) int { return cmp.Compare(a.key, b.key) })
+
+	for _, buckets := range []int{1, 50, 5000} {
+		result := BucketSortByKey(records, buckets, func(r record) int { return r.key })
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("BucketSortByKey with %d buckets is not stable", buckets)
+		}
+	}
+}
+
+// TestBucketSortInstrumented tests the operation counts of the instrumented version
+func TestBucketSortInstrumented(t *testing.T) {
+	testCases := []struct {
+		name        string
+		input       []int
+		comparisons int64
+		writes      int64
+	}{
+		{
+			name:        "Evenly spread values get a bucket each",
+			input:       []int{5, 4, 3, 2, 1},
+			comparisons: 0,
+			writes:      5,
+		},
+		{
+			name:        "Equal values share a single bucket",
+			input:       []int{3, 3, 3, 3, 3},
+			comparisons: 4,
+			writes:      9,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			counter := pkg.NewOperationCounter()
+			result := BucketSortInstrumented(tc.input, counter)
+
+			if !reflect.DeepEqual(result, BucketSort(tc.input)) {
+				t.Errorf("BucketSortInstrumented(%v) = %v; want sorted output", tc.input, result)
+			}
+			if counter.Comparisons != tc.comparisons || counter.Writes != tc.writes {
+				t.Errorf("counted %d comparisons and %d writes; want %d and %d",
+					counter.Comparisons, counter.Writes, tc.comparisons, tc.writes)
+			}
+			if counter.Allocations != 3 {
+				t.Errorf("counted %d allocations; want 3", counter.Allocations)
+			}
+		})
+	}
+}
+
+// BenchmarkBucketSort compares BucketSort with slices.Sort on uniform and skewed inputs
+func BenchmarkBucketSort(b *testing.B) {
+	generator := pkg.NewRandomGeneratorWithSeed(42)
+
+	for _, distribution := range []pkg.Distribution{pkg.Uniform, pkg.Gaussian, pkg.Zipf} {
+		input := generator.GenerateDistribution(distribution, 100000)
+
+		b.Run(fmt.Sprintf("%v/BucketSort", distribution), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				BucketSort(input)
+			}
+		})
+		b.Run(fmt.Sprintf("%v/slices.Sort", distribution), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				slices.Sort(slices.Clone(input))
+			}
+		})
+	}
+}
+
+// FuzzBucketSort checks the sort properties of every Bucket Sort variant on fuzzed inputs
+func FuzzBucketSort(f *testing.F) {
+	for _, tc := range bucketSortTestCases {
+		f.Add(pkg.BytesFromInts(tc.input))
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		input := pkg.IntsFromBytes(data)
+		checks := map[string]error{
+			"BucketSort":            pkg.CheckSort(BucketSort, input),
+			"BucketSortWithBuckets": pkg.CheckSort(func(arr []int) []int { return BucketSortWithBuckets(arr, 3) }, input),
+			"BucketSortByKey":       pkg.CheckSort(func(arr []int) []int { return BucketSortByKey(arr, len(arr), identity) }, input),
+			"BucketSortInPlace":     pkg.CheckSortInPlace(BucketSortInPlace, input),
+			"NewSorter":             pkg.CheckSorter(NewSorter(pkg.NewOperationCounter()), input),
+		}
+		for name, err := range checks {
+			if err != nil {
+				t.Errorf("%s: %v", name, err)
+			}
+		}
+	})
+}
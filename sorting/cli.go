@@ -0,0 +1,716 @@
+package sorting
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/JoaoVitor615/algorithms-in-go/pkg"
+)
+
+// Exit codes returned by CLI.Run
+const (
+	ExitOK                 = 0 // command succeeded and every result is sorted
+	ExitError              = 1 // command failed, e.g. the input file could not be read
+	ExitUsage              = 2 // invalid subcommand, flag or algorithm
+	ExitVerificationFailed = 3 // command ran but a result was not correctly sorted
+)
+
+// outputText is the human-readable --output format, every other value is a pkg.ExportFormat
+const outputText = "text"
+
+// CLI runs the sorting use cases from command-line arguments, without interactive prompts
+type CLI struct {
+	useCase *UseCase
+	stdin   io.Reader
+	stdout  io.Writer
+	stderr  io.Writer
+}
+
+// NewCLI creates a CLI reading input from stdin and writing to stdout and stderr
+func NewCLI(stdin io.Reader, stdout, stderr io.Writer) *CLI {
+	return &CLI{
+		useCase: NewUseCase(),
+		stdin:   stdin,
+		stdout:  stdout,
+		stderr:  stderr,
+	}
+}
+
+// Run executes the subcommand in args (without the program name) and returns its exit code
+func (c *CLI) Run(args []string) int {
+	if len(args) == 0 {
+		c.printUsage()
+		return ExitUsage
+	}
+
+	switch args[0] {
+	case "sort":
+		return c.runSort(args[1:])
+	case "bench":
+		return c.runBench(args[1:])
+	case "compare":
+		return c.runCompare(args[1:])
+	case "trace":
+		return c.runTrace(args[1:])
+	case "replay":
+		return c.runReplay(args[1:])
+	case "list":
+		return c.runList()
+	case "help", "-h", "--help":
+		c.printUsage()
+		return ExitOK
+	default:
+		fmt.Fprintf(c.stderr, "unknown command %q\n\n", args[0])
+		c.printUsage()
+		return ExitUsage
+	}
+}
+
+func (c *CLI) printUsage() {
+	fmt.Fprintln(c.stderr, `Usage:
+  algorithms-in-go                      start the interactive menu
+  algorithms-in-go sort  [flags]        sort numbers from a file, stdin or a random list
+  algorithms-in-go bench [flags]        benchmark one or more algorithms
+  algorithms-in-go compare [flags]      race algorithms on identical inputs and rank them
+  algorithms-in-go trace [flags]        record every step of a sort to a JSON-lines or binary trace
+  algorithms-in-go replay [flags]       re-render or summarize a recorded trace
+  algorithms-in-go list                 list the available algorithms
+
+Run "algorithms-in-go <command> -h" for the flags of a command.
+
+Exit codes: 0 success, 1 error, 2 invalid usage, 3 verification failed`)
+}
+
+func (c *CLI) runSort(args []string) int {
+	flags := flag.NewFlagSet("sort", flag.ContinueOnError)
+	flags.SetOutput(c.stderr)
+	algo := flags.String("algo", "", "algorithm ID or name (required), see the list command")
+	input := flags.String("input", "", `file with integers separated by spaces, commas or newlines ("-" for stdin)`)
+	count := flags.Int("count", 0, "sort this many random numbers instead of an input file")
+	seed := flags.Int64("seed", 0, "seed for the random numbers of --count, 0 picks one from the clock")
+	dist := flags.String("dist", pkg.Uniform.String(), "input distribution of --count: "+distributionList())
+	output := flags.String("output", outputText, "output format: text, json, csv or markdown")
+
+	if code, ok := c.parseFlags(flags, args); !ok {
+		return code
+	}
+
+	switch {
+	case *algo == "":
+		return c.usageError("sort: --algo is required")
+	case *input == "" && *count <= 0:
+		return c.usageError("sort: provide --input or a positive --count")
+	case *input != "" && *count > 0:
+		return c.usageError("sort: --input and --count cannot be used together")
+	}
+
+	format, err := parseOutput(*output)
+	if err != nil {
+		return c.usageError(fmt.Sprintf("sort: %v", err))
+	}
+
+	distribution, err := pkg.ParseDistribution(*dist)
+	if err != nil {
+		return c.usageError(fmt.Sprintf("sort: %v", err))
+	}
+
+	algorithm, err := c.useCase.registry.Lookup(*algo)
+	if err != nil {
+		return c.usageError(err.Error())
+	}
+	c.applySeed(*seed)
+
+	var result SortResult
+	if *input != "" {
+		numbers, err := c.readNumbers(*input)
+		if err != nil {
+			fmt.Fprintf(c.stderr, "sort: %v\n", err)
+			return ExitError
+		}
+		result, err = c.useCase.ManualSort(algorithm.ID, numbers)
+		if err != nil {
+			fmt.Fprintf(c.stderr, "sort: %v\n", err)
+			return ExitError
+		}
+	} else {
+		result, err = c.useCase.CustomRandomSort(algorithm.ID, *count, distribution)
+		if err != nil {
+			fmt.Fprintf(c.stderr, "sort: %v\n", err)
+			return ExitError
+		}
+	}
+
+	if format == outputText {
+		fmt.Fprintf(c.stderr, "%s: sorted %s numbers in %v\n", algorithm.Name, pkg.FormatNumber(result.Count), result.Duration)
+		for _, value := range result.SortedArray {
+			fmt.Fprintln(c.stdout, value)
+		}
+	} else if err := ExportSortResult(c.stdout, format, result); err != nil {
+		fmt.Fprintf(c.stderr, "sort: %v\n", err)
+		return ExitError
+	}
+
+	if !result.IsSorted {
+		fmt.Fprintf(c.stderr, "sort: verification failed, %s did not sort the input correctly\n", algorithm.Name)
+		return ExitVerificationFailed
+	}
+	return ExitOK
+}
+
+func (c *CLI) runBench(args []string) int {
+	flags := flag.NewFlagSet("bench", flag.ContinueOnError)
+	flags.SetOutput(c.stderr)
+	algos := flags.String("algo", "", `comma-separated algorithm IDs or names, or "all" (required)`)
+	sizesFlag := flags.String("sizes", joinSizes(DefaultBenchmarkOptions.Sizes), "comma-separated input sizes, scientific notation allowed (e.g. 1e3,1e5)")
+	runs := flags.Int("runs", DefaultBenchmarkOptions.Runs, "timed runs per size, the reported duration is their median")
+	warmup := flags.Int("warmup", DefaultBenchmarkOptions.WarmupRuns, "untimed warm-up runs per size")
+	seed := flags.Int64("seed", 0, "seed for the random inputs, 0 picks one from the clock")
+	dist := flags.String("dist", pkg.Uniform.String(), "input distribution: "+distributionList())
+	output := flags.String("output", outputText, "output format: text, json, csv or markdown")
+
+	if code, ok := c.parseFlags(flags, args); !ok {
+		return code
+	}
+
+	if *algos == "" {
+		return c.usageError("bench: --algo is required")
+	}
+	if *runs < 1 {
+		return c.usageError("bench: --runs must be at least 1")
+	}
+	if *warmup < 0 {
+		return c.usageError("bench: --warmup cannot be negative")
+	}
+
+	format, err := parseOutput(*output)
+	if err != nil {
+		return c.usageError(fmt.Sprintf("bench: %v", err))
+	}
+
+	distribution, err := pkg.ParseDistribution(*dist)
+	if err != nil {
+		return c.usageError(fmt.Sprintf("bench: %v", err))
+	}
+
+	sizes, err := parseSizes(*sizesFlag)
+	if err != nil {
+		return c.usageError(fmt.Sprintf("bench: %v", err))
+	}
+
+	algorithms, err := c.selectAlgorithms(*algos)
+	if err != nil {
+		return c.usageError(fmt.Sprintf("bench: %v", err))
+	}
+	c.applySeed(*seed)
+
+	summaries := make([]BenchmarkSummary, 0, len(algorithms))
+	allSorted := true
+
+	for _, algorithm := range algorithms {
+		summary, err := c.useCase.RunBenchmarks(algorithm.ID, BenchmarkOptions{
+			Sizes:        sizes,
+			Runs:         *runs,
+			WarmupRuns:   *warmup,
+			Distribution: distribution,
+		})
+		if err != nil {
+			fmt.Fprintf(c.stderr, "bench: %v\n", err)
+			return ExitError
+		}
+
+		for _, result := range summary.Results {
+			allSorted = allSorted && result.IsSorted
+		}
+		summaries = append(summaries, summary)
+	}
+
+	if format == outputText {
+		c.printBenchSummaries(summaries)
+	} else if err := pkg.ExportBenchmarks(c.stdout, format, summaries); err != nil {
+		fmt.Fprintf(c.stderr, "bench: %v\n", err)
+		return ExitError
+	}
+
+	if !allSorted {
+		fmt.Fprintln(c.stderr, "bench: verification failed, at least one result was not sorted correctly")
+		return ExitVerificationFailed
+	}
+	return ExitOK
+}
+
+func (c *CLI) printBenchSummaries(summaries []BenchmarkSummary) {
+	table := tabwriter.NewWriter(c.stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(table, "ALGORITHM\tSIZE\tRUNS\tMEDIAN\tMEAN\tSTDDEV\tP95\t95% CI\tSORTED")
+	for _, summary := range summaries {
+		for _, result := range summary.Results {
+			stats := result.Stats
+			fmt.Fprintf(table, "%s\t%d\t%d\t%v\t%v\t%v\t%v\t%v – %v\t%t\n",
+				summary.Algorithm, result.Count, stats.Runs,
+				stats.Median, stats.Mean, stats.StdDev, stats.P95, stats.CILow, stats.CIHigh,
+				result.IsSorted)
+		}
+	}
+	table.Flush()
+
+	fmt.Fprintln(c.stdout)
+	for _, summary := range summaries {
+		if summary.Fit == nil {
+			fmt.Fprintf(c.stdout, "%s: declared %s, not enough data to fit (needs at least three distinct sizes)\n", summary.Algorithm, summary.Class)
+			continue
+		}
+		fmt.Fprintf(c.stdout, "%s: declared %s, best fit %s (R² = %.4f), exponent n^%.2f\n",
+			summary.Algorithm, summary.Class, summary.Fit.Class, summary.Fit.RSquared, summary.Fit.Exponent)
+	}
+
+	for _, summary := range summaries {
+		if summary.Baseline == "" {
+			continue
+		}
+
+		speedups := make([]string, len(summary.Results))
+		for i, result := range summary.Results {
+			speedups[i] = fmt.Sprintf("%d → %.2fx", result.Count, result.Speedup)
+		}
+		fmt.Fprintf(c.stdout, "%s: speedup vs %s with GOMAXPROCS %d: %s\n",
+			summary.Algorithm, summary.Baseline, summary.Environment.MaxProcs, strings.Join(speedups, ", "))
+	}
+}
+
+func (c *CLI) runCompare(args []string) int {
+	flags := flag.NewFlagSet("compare", flag.ContinueOnError)
+	flags.SetOutput(c.stderr)
+	algos := flags.String("algo", "all", `comma-separated algorithm IDs or names, or "all"`)
+	sizesFlag := flags.String("sizes", joinSizes(DefaultBenchmarkOptions.Sizes), "comma-separated input sizes, scientific notation allowed (e.g. 1e3,1e5)")
+	dists := flags.String("dist", pkg.Uniform.String(), "comma-separated input distributions: "+distributionList())
+	runs := flags.Int("runs", DefaultBenchmarkOptions.Runs, "timed runs per algorithm on each dataset, ranked by their median")
+	warmup := flags.Int("warmup", DefaultBenchmarkOptions.WarmupRuns, "untimed warm-up runs per algorithm on each dataset")
+	seed := flags.Int64("seed", 0, "seed for the random inputs, 0 picks one from the clock")
+	output := flags.String("output", outputText, "output format: text, json, csv or markdown")
+
+	if code, ok := c.parseFlags(flags, args); !ok {
+		return code
+	}
+	if *runs < 1 {
+		return c.usageError("compare: --runs must be at least 1")
+	}
+	if *warmup < 0 {
+		return c.usageError("compare: --warmup cannot be negative")
+	}
+
+	format, err := parseOutput(*output)
+	if err != nil {
+		return c.usageError(fmt.Sprintf("compare: %v", err))
+	}
+
+	sizes, err := parseSizes(*sizesFlag)
+	if err != nil {
+		return c.usageError(fmt.Sprintf("compare: %v", err))
+	}
+
+	var distributions []pkg.Distribution
+	for _, id := range strings.Split(*dists, ",") {
+		distribution, err := pkg.ParseDistribution(id)
+		if err != nil {
+			return c.usageError(fmt.Sprintf("compare: %v", err))
+		}
+		distributions = append(distributions, distribution)
+	}
+
+	algorithms, err := c.selectAlgorithms(*algos)
+	if err != nil {
+		return c.usageError(fmt.Sprintf("compare: %v", err))
+	}
+	ids := make([]string, len(algorithms))
+	for i, algorithm := range algorithms {
+		ids[i] = algorithm.ID
+	}
+	c.applySeed(*seed)
+
+	comparison, err := c.useCase.Compare(CompareOptions{
+		Algorithms:    ids,
+		Sizes:         sizes,
+		Distributions: distributions,
+		Runs:          *runs,
+		WarmupRuns:    *warmup,
+	})
+	if err != nil {
+		fmt.Fprintf(c.stderr, "compare: %v\n", err)
+		return ExitError
+	}
+
+	if format == outputText {
+		c.printComparison(comparison)
+	} else if err := ExportComparison(c.stdout, format, comparison); err != nil {
+		fmt.Fprintf(c.stderr, "compare: %v\n", err)
+		return ExitError
+	}
+
+	if !comparison.Agree() {
+		fmt.Fprintln(c.stderr, "compare: verification failed, the algorithms did not all produce the sorted dataset")
+		return ExitVerificationFailed
+	}
+	return ExitOK
+}
+
+func (c *CLI) printComparison(comparison Comparison) {
+	for i, comparisonCase := range comparison.Cases {
+		if i > 0 {
+			fmt.Fprintln(c.stdout)
+		}
+		fmt.Fprintf(c.stdout, "%s numbers, %s\n", pkg.FormatNumber(comparisonCase.Count), comparisonCase.Distribution.Name())
+
+		table := tabwriter.NewWriter(c.stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(table, "RANK\tALGORITHM\tMEDIAN\tSTDDEV\tP95\tVS FASTEST\tCORRECT")
+		for _, entry := range comparisonCase.Entries {
+			fmt.Fprintf(table, "%d\t%s\t%v\t%v\t%v\t%.2fx\t%t\n",
+				entry.Rank, entry.Algorithm, entry.Stats.Median, entry.Stats.StdDev, entry.Stats.P95, entry.Relative, entry.Matches)
+		}
+		table.Flush()
+	}
+}
+
+func (c *CLI) runTrace(args []string) int {
+	flags := flag.NewFlagSet("trace", flag.ContinueOnError)
+	flags.SetOutput(c.stderr)
+	algo := flags.String("algo", "", "algorithm ID or name (required), see the list command")
+	input := flags.String("input", "", `file with integers separated by spaces, commas or newlines ("-" for stdin)`)
+	count := flags.Int("count", 0, "trace the sort of this many random numbers instead of an input file")
+	seed := flags.Int64("seed", 0, "seed for the random numbers of --count, 0 picks one from the clock")
+	dist := flags.String("dist", pkg.Uniform.String(), "input distribution of --count: "+distributionList())
+	out := flags.String("out", "-", `file to write the trace to ("-" for stdout)`)
+	formatName := flags.String("format", string(pkg.TraceJSONLines), "trace format: jsonl or binary")
+
+	if code, ok := c.parseFlags(flags, args); !ok {
+		return code
+	}
+
+	switch {
+	case *algo == "":
+		return c.usageError("trace: --algo is required")
+	case *input == "" && *count <= 0:
+		return c.usageError("trace: provide --input or a positive --count")
+	case *input != "" && *count > 0:
+		return c.usageError("trace: --input and --count cannot be used together")
+	}
+
+	format, err := pkg.ParseTraceFormat(*formatName)
+	if err != nil {
+		return c.usageError(fmt.Sprintf("trace: %v", err))
+	}
+
+	distribution, err := pkg.ParseDistribution(*dist)
+	if err != nil {
+		return c.usageError(fmt.Sprintf("trace: %v", err))
+	}
+
+	algorithm, err := c.useCase.registry.Lookup(*algo)
+	if err != nil {
+		return c.usageError(err.Error())
+	}
+	if algorithm.SortTraced == nil {
+		return c.usageError(fmt.Sprintf("trace: %s: %v", algorithm.Name, ErrTracingUnsupported))
+	}
+	c.applySeed(*seed)
+
+	var trace pkg.Trace
+	if *input != "" {
+		numbers, err := c.readNumbers(*input)
+		if err != nil {
+			fmt.Fprintf(c.stderr, "trace: %v\n", err)
+			return ExitError
+		}
+		trace, err = c.useCase.TraceSort(algorithm.ID, numbers)
+		if err != nil {
+			fmt.Fprintf(c.stderr, "trace: %v\n", err)
+			return ExitError
+		}
+	} else {
+		trace, err = c.useCase.TraceRandomSort(algorithm.ID, *count, distribution)
+		if err != nil {
+			fmt.Fprintf(c.stderr, "trace: %v\n", err)
+			return ExitError
+		}
+	}
+
+	if err := c.writeTrace(*out, format, trace); err != nil {
+		fmt.Fprintf(c.stderr, "trace: %v\n", err)
+		return ExitError
+	}
+	fmt.Fprintf(c.stderr, "%s: recorded %s events sorting %s numbers\n",
+		algorithm.Name, pkg.FormatNumber(len(trace.Events)), pkg.FormatNumber(len(trace.Input)))
+
+	summary, err := pkg.SummarizeTrace(trace)
+	if err != nil || !summary.Sorted {
+		fmt.Fprintf(c.stderr, "trace: verification failed, replaying the trace of %s does not sort the input\n", algorithm.Name)
+		return ExitVerificationFailed
+	}
+	return ExitOK
+}
+
+// writeTrace writes trace to a file, or to stdout when path is "-"
+func (c *CLI) writeTrace(path string, format pkg.TraceFormat, trace pkg.Trace) error {
+	if path == "-" {
+		return pkg.WriteTrace(c.stdout, format, trace)
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := pkg.WriteTrace(file, format, trace); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+func (c *CLI) runReplay(args []string) int {
+	flags := flag.NewFlagSet("replay", flag.ContinueOnError)
+	flags.SetOutput(c.stderr)
+	path := flags.String("trace", "", `trace file written by the trace command, in either format ("-" for stdin, required)`)
+	summaryOnly := flags.Bool("summary", false, "print event counts instead of replaying every step")
+	height := flags.Int("height", 8, "rows of the bar chart drawn after every swap and write, 0 prints the events only")
+
+	if code, ok := c.parseFlags(flags, args); !ok {
+		return code
+	}
+
+	if *path == "" {
+		return c.usageError("replay: --trace is required")
+	}
+	if *height < 0 {
+		return c.usageError("replay: --height cannot be negative")
+	}
+
+	trace, err := c.readTrace(*path)
+	if err != nil {
+		fmt.Fprintf(c.stderr, "replay: %v\n", err)
+		return ExitError
+	}
+
+	var summary pkg.TraceSummary
+	if *summaryOnly {
+		summary, err = pkg.SummarizeTrace(trace)
+		if err == nil {
+			c.printTraceSummary(summary)
+		}
+	} else {
+		summary, err = c.replayTrace(trace, *height)
+	}
+	if err != nil {
+		fmt.Fprintf(c.stderr, "replay: %v\n", err)
+		return ExitError
+	}
+
+	if !summary.Sorted {
+		fmt.Fprintf(c.stderr, "replay: verification failed, the trace of %s does not leave its input sorted\n", trace.Algorithm)
+		return ExitVerificationFailed
+	}
+	return ExitOK
+}
+
+// readTrace reads a trace from a file, or from stdin when path is "-"
+func (c *CLI) readTrace(path string) (pkg.Trace, error) {
+	if path == "-" {
+		return pkg.ReadTrace(c.stdin)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return pkg.Trace{}, err
+	}
+	defer file.Close()
+	return pkg.ReadTrace(file)
+}
+
+// replayTrace prints every event of trace, drawing the array after each swap and write
+func (c *CLI) replayTrace(trace pkg.Trace, height int) (pkg.TraceSummary, error) {
+	fmt.Fprintf(c.stdout, "%s: %s events on %s numbers\n", trace.Algorithm,
+		pkg.FormatNumber(len(trace.Events)), pkg.FormatNumber(len(trace.Input)))
+	fmt.Fprintf(c.stdout, "input: %v\n", trace.Input)
+	fmt.Fprint(c.stdout, pkg.RenderBars(trace.Input, height))
+
+	step := 0
+	_, err := pkg.ReplayTrace(trace, func(state []int, event pkg.TraceEvent) {
+		step++
+		fmt.Fprintf(c.stdout, "%d: %v\n", step, event)
+
+		switch event.Kind {
+		case pkg.TraceSwap:
+			fmt.Fprint(c.stdout, pkg.RenderBars(state, height, event.I, event.J))
+		case pkg.TraceWrite:
+			fmt.Fprint(c.stdout, pkg.RenderBars(state, height, event.I))
+		}
+	})
+	if err != nil {
+		return pkg.TraceSummary{}, err
+	}
+
+	summary, err := pkg.SummarizeTrace(trace)
+	if err != nil {
+		return pkg.TraceSummary{}, err
+	}
+	fmt.Fprintf(c.stdout, "result: %v\n", summary.Result)
+	return summary, nil
+}
+
+func (c *CLI) printTraceSummary(summary pkg.TraceSummary) {
+	fmt.Fprintf(c.stdout, "%s: %s events on %s numbers, max recursion depth %d, sorted %t\n",
+		summary.Algorithm, pkg.FormatNumber(summary.Events), pkg.FormatNumber(summary.Length), summary.MaxDepth, summary.Sorted)
+
+	table := tabwriter.NewWriter(c.stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(table, "EVENT\tCOUNT")
+	for _, kind := range pkg.TraceEventKinds() {
+		if count := summary.Counts[kind]; count > 0 {
+			fmt.Fprintf(table, "%s\t%d\n", kind, count)
+		}
+	}
+	table.Flush()
+}
+
+func (c *CLI) runList() int {
+	table := tabwriter.NewWriter(c.stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(table, "ID\tNAME\tAVERAGE\tWORST\tSTABLE\tKIND")
+	for _, algorithm := range c.useCase.Algorithms() {
+		fmt.Fprintf(table, "%s\t%s\t%s\t%s\t%t\t%s\n",
+			algorithm.ID,
+			algorithm.Name,
+			algorithm.Complexity.Average,
+			algorithm.Complexity.Worst,
+			algorithm.Stable,
+			algorithm.Kind)
+	}
+	table.Flush()
+	return ExitOK
+}
+
+// parseFlags parses args into flags and reports whether the command should continue
+// When it should not, the returned code is the exit code: ExitOK for -h, ExitUsage otherwise
+func (c *CLI) parseFlags(flags *flag.FlagSet, args []string) (int, bool) {
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return ExitOK, false
+		}
+		return ExitUsage, false
+	}
+	if flags.NArg() > 0 {
+		fmt.Fprintf(c.stderr, "%s: unexpected argument %q\n", flags.Name(), flags.Arg(0))
+		return ExitUsage, false
+	}
+	return ExitOK, true
+}
+
+func (c *CLI) usageError(message string) int {
+	fmt.Fprintln(c.stderr, message)
+	return ExitUsage
+}
+
+// selectAlgorithms resolves a comma-separated list of algorithm IDs or names
+func (c *CLI) selectAlgorithms(list string) ([]Algorithm, error) {
+	if strings.TrimSpace(list) == "all" {
+		return c.useCase.Algorithms(), nil
+	}
+
+	var algorithms []Algorithm
+	for _, name := range strings.Split(list, ",") {
+		algorithm, err := c.useCase.registry.Lookup(strings.TrimSpace(name))
+		if err != nil {
+			return nil, err
+		}
+		algorithms = append(algorithms, algorithm)
+	}
+	return algorithms, nil
+}
+
+// readNumbers reads integers from a file, or from stdin when path is "-"
+func (c *CLI) readNumbers(path string) ([]int, error) {
+	var data []byte
+	var err error
+
+	if path == "-" {
+		data, err = io.ReadAll(c.stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	fields := strings.FieldsFunc(string(data), func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t' || r == '\n' || r == '\r'
+	})
+
+	numbers := make([]int, 0, len(fields))
+	for i, field := range fields {
+		number, err := strconv.Atoi(field)
+		if err != nil {
+			return nil, fmt.Errorf("value %d (%q) is not an integer", i+1, field)
+		}
+		numbers = append(numbers, number)
+	}
+	return numbers, nil
+}
+
+// applySeed makes the random inputs reproducible, a zero seed keeps the clock-based one
+func (c *CLI) applySeed(seed int64) {
+	if seed != 0 {
+		c.useCase.generator = pkg.NewRandomGeneratorWithSeed(seed)
+	}
+}
+
+// distributionList returns the identifiers accepted by --dist, separated by commas
+func distributionList() string {
+	ids := make([]string, 0, len(pkg.Distributions()))
+	for _, distribution := range pkg.Distributions() {
+		ids = append(ids, distribution.String())
+	}
+	return strings.Join(ids, ", ")
+}
+
+// parseOutput maps an --output value to an export format, or outputText for the human-readable output
+func parseOutput(name string) (pkg.ExportFormat, error) {
+	if name == outputText {
+		return outputText, nil
+	}
+	return pkg.ParseExportFormat(name)
+}
+
+// parseSizes parses a comma-separated list of positive sizes such as "1000,1e5"
+func parseSizes(list string) ([]int, error) {
+	var sizes []int
+
+	for _, field := range strings.Split(list, ",") {
+		field = strings.TrimSpace(field)
+
+		size, err := strconv.Atoi(field)
+		if err != nil {
+			value, floatErr := strconv.ParseFloat(field, 64)
+			if floatErr != nil || value != math.Trunc(value) || value > math.MaxInt32 {
+				return nil, fmt.Errorf("invalid size %q", field)
+			}
+			size = int(value)
+		}
+
+		if size < 1 {
+			return nil, fmt.Errorf("size %q must be at least 1", field)
+		}
+		sizes = append(sizes, size)
+	}
+
+	return sizes, nil
+}
+
+func joinSizes(sizes []int) string {
+	fields := make([]string, len(sizes))
+	for i, size := range sizes {
+		fields[i] = strconv.Itoa(size)
+	}
+	return strings.Join(fields, ",")
+}
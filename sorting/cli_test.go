@@ -0,0 +1,218 @@
+package sorting
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/JoaoVitor615/algorithms-in-go/pkg"
+)
+
+// TestParseSizes tests plain and scientific notation sizes and invalid values
+func TestParseSizes(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		expected []int
+		wantErr  bool
+	}{
+		{name: "Plain integers", input: "500,1000", expected: []int{500, 1000}},
+		{name: "Scientific notation", input: "1e3, 1e5", expected: []int{1000, 100000}},
+		{name: "Fractional size", input: "1.5", wantErr: true},
+		{name: "Zero size", input: "0", wantErr: true},
+		{name: "Not a number", input: "ten", wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sizes, err := parseSizes(tc.input)
+
+			if tc.wantErr {
+				if err == nil {
+					t.Errorf("parseSizes(%q) = %v; want an error", tc.input, sizes)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("parseSizes(%q) returned unexpected error: %v", tc.input, err)
+			}
+			if !reflect.DeepEqual(sizes, tc.expected) {
+				t.Errorf("parseSizes(%q) = %v; want %v", tc.input, sizes, tc.expected)
+			}
+		})
+	}
+}
+
+// TestCLIExitCodes tests the exit code of each kind of invocation
+func TestCLIExitCodes(t *testing.T) {
+	input := filepath.Join(t.TempDir(), "numbers.txt")
+	if err := os.WriteFile(input, []byte("5 3,9\n1\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name     string
+		args     []string
+		expected int
+	}{
+		{name: "No command", args: nil, expected: ExitUsage},
+		{name: "Unknown command", args: []string{"shuffle"}, expected: ExitUsage},
+		{name: "List", args: []string{"list"}, expected: ExitOK},
+		{name: "Sort file", args: []string{"sort", "--algo", "quick", "--input", input}, expected: ExitOK},
+		{name: "Sort random", args: []string{"sort", "--algo", "merge", "--count", "100"}, expected: ExitOK},
+		{name: "Sort without algorithm", args: []string{"sort", "--input", input}, expected: ExitUsage},
+		{name: "Sort unknown algorithm", args: []string{"sort", "--algo", "bogo", "--input", input}, expected: ExitUsage},
+		{name: "Sort missing file", args: []string{"sort", "--algo", "quick", "--input", input + ".missing"}, expected: ExitError},
+		{name: "Sort bad output", args: []string{"sort", "--algo", "quick", "--input", input, "--output", "xml"}, expected: ExitUsage},
+		{name: "Bench", args: []string{"bench", "--algo", "heap,insertion", "--sizes", "1e2,2e2", "--runs", "2"}, expected: ExitOK},
+		{name: "Bench CSV", args: []string{"bench", "--algo", "quick", "--sizes", "100", "--output", "csv"}, expected: ExitOK},
+		{name: "Sort Markdown", args: []string{"sort", "--algo", "heap", "--count", "10", "--seed", "7", "--output", "md"}, expected: ExitOK},
+		{name: "Bench distribution", args: []string{"bench", "--algo", "quick", "--sizes", "100,200", "--dist", "median3-killer"}, expected: ExitOK},
+		{name: "Sort unknown distribution", args: []string{"sort", "--algo", "quick", "--count", "10", "--dist", "bimodal"}, expected: ExitUsage},
+		{name: "Compare", args: []string{"compare", "--algo", "quick,merge", "--sizes", "100", "--dist", "sorted,zipf", "--runs", "2"}, expected: ExitOK},
+		{name: "Compare Markdown", args: []string{"compare", "--sizes", "50", "--runs", "1", "--output", "markdown"}, expected: ExitOK},
+		{name: "Bench zero runs", args: []string{"bench", "--algo", "heap", "--runs", "0"}, expected: ExitUsage},
+		{name: "Compare zero runs", args: []string{"compare", "--algo", "quick", "--sizes", "50", "--runs", "0"}, expected: ExitUsage},
+		{name: "Compare negative warm-up", args: []string{"compare", "--algo", "quick", "--sizes", "50", "--warmup", "-1"}, expected: ExitUsage},
+		{name: "Compare unknown algorithm", args: []string{"compare", "--algo", "quick,bogo", "--sizes", "50"}, expected: ExitUsage},
+		{name: "Compare bad size", args: []string{"compare", "--algo", "quick", "--sizes", "0"}, expected: ExitUsage},
+		{name: "Trace file", args: []string{"trace", "--algo", "insertion", "--input", input}, expected: ExitOK},
+		{name: "Trace binary", args: []string{"trace", "--algo", "heap", "--count", "50", "--format", "binary", "--out", filepath.Join(t.TempDir(), "heap.trace")}, expected: ExitOK},
+		{name: "Trace unsupported algorithm", args: []string{"trace", "--algo", "tim", "--count", "10"}, expected: ExitUsage},
+		{name: "Trace bad format", args: []string{"trace", "--algo", "quick", "--count", "10", "--format", "xml"}, expected: ExitUsage},
+		{name: "Replay without trace", args: []string{"replay", "--summary"}, expected: ExitUsage},
+		{name: "Replay missing file", args: []string{"replay", "--trace", input + ".missing"}, expected: ExitError},
+		{name: "Replay malformed trace", args: []string{"replay", "--trace", input}, expected: ExitError},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			cli := NewCLI(strings.NewReader(""), &stdout, &stderr)
+
+			if code := cli.Run(tc.args); code != tc.expected {
+				t.Errorf("Run(%q) = %d; want %d (stderr: %s)", tc.args, code, tc.expected, stderr.String())
+			}
+		})
+	}
+}
+
+// TestCLISortJSON tests that the sort command reads stdin and reports the sorted values as JSON
+func TestCLISortJSON(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	cli := NewCLI(strings.NewReader("4 -2 7 0"), &stdout, &stderr)
+
+	if code := cli.Run([]string{"sort", "--algo", "merge", "--input", "-", "--output", "json"}); code != ExitOK {
+		t.Fatalf("Run returned %d; want %d (stderr: %s)", code, ExitOK, stderr.String())
+	}
+
+	var report sortResultJSON
+	if err := json.Unmarshal(stdout.Bytes(), &report); err != nil {
+		t.Fatalf("output is not valid JSON: %v", err)
+	}
+
+	if !reflect.DeepEqual(report.Values, []int{-2, 0, 4, 7}) || !report.Sorted || report.Count != 4 {
+		t.Errorf("report = %+v; want sorted values [-2 0 4 7]", report)
+	}
+	if report.Operations == nil || report.Operations.Comparisons == 0 {
+		t.Errorf("report.Operations = %+v; want measured comparisons", report.Operations)
+	}
+}
+
+// TestCLIVerificationFailed tests that an algorithm returning unsorted output fails with ExitVerificationFailed
+func TestCLIVerificationFailed(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	cli := NewCLI(strings.NewReader("3 1 2"), &stdout, &stderr)
+
+	identity := Algorithm{ID: "identity", Name: "Identity", Complexity: Complexity{Average: "O(n)"}, Kind: ArrayAlgorithm, Sorter: pkg.SorterFunc(func([]int) {})}
+	if err := cli.useCase.registry.Register(identity); err != nil {
+		t.Fatal(err)
+	}
+
+	if code := cli.Run([]string{"sort", "--algo", "identity", "--input", "-"}); code != ExitVerificationFailed {
+		t.Errorf("Run returned %d; want %d", code, ExitVerificationFailed)
+	}
+	if code := cli.Run([]string{"bench", "--algo", "identity", "--sizes", "50"}); code != ExitVerificationFailed {
+		t.Errorf("Run returned %d; want %d", code, ExitVerificationFailed)
+	}
+	if code := cli.Run([]string{"compare", "--algo", "quick,identity", "--sizes", "50", "--runs", "1"}); code != ExitVerificationFailed {
+		t.Errorf("Run returned %d; want %d", code, ExitVerificationFailed)
+	}
+}
+
+// TestCLISeedReproducible tests that the same --seed sorts the same random input
+func TestCLISeedReproducible(t *testing.T) {
+	run := func() sortResultJSON {
+		var stdout, stderr bytes.Buffer
+		cli := NewCLI(strings.NewReader(""), &stdout, &stderr)
+
+		if code := cli.Run([]string{"sort", "--algo", "insertion", "--count", "20", "--seed", "42", "--output", "json"}); code != ExitOK {
+			t.Fatalf("Run returned %d; want %d (stderr: %s)", code, ExitOK, stderr.String())
+		}
+
+		var report sortResultJSON
+		if err := json.Unmarshal(stdout.Bytes(), &report); err != nil {
+			t.Fatalf("output is not valid JSON: %v", err)
+		}
+		return report
+	}
+
+	first, second := run(), run()
+	if !reflect.DeepEqual(first.Values, second.Values) {
+		t.Errorf("values differ between runs with the same seed: %v and %v", first.Values, second.Values)
+	}
+	if first.Environment.Seed != 42 {
+		t.Errorf("Environment.Seed = %d; want 42", first.Environment.Seed)
+	}
+}
+
+// TestCLITraceReplay tests that a trace written in either format replays to the sorted input
+// and that a trace which does not sort its input fails verification
+func TestCLITraceReplay(t *testing.T) {
+	dir := t.TempDir()
+
+	for _, format := range []string{"jsonl", "binary"} {
+		t.Run(format, func(t *testing.T) {
+			path := filepath.Join(dir, "quick."+format)
+
+			var stdout, stderr bytes.Buffer
+			cli := NewCLI(strings.NewReader("4 -2 7 0"), &stdout, &stderr)
+			if code := cli.Run([]string{"trace", "--algo", "quick", "--input", "-", "--format", format, "--out", path}); code != ExitOK {
+				t.Fatalf("trace returned %d; want %d (stderr: %s)", code, ExitOK, stderr.String())
+			}
+
+			stdout.Reset()
+			if code := cli.Run([]string{"replay", "--trace", path, "--height", "0"}); code != ExitOK {
+				t.Fatalf("replay returned %d; want %d (stderr: %s)", code, ExitOK, stderr.String())
+			}
+			if output := stdout.String(); !strings.Contains(output, "pivot 3") || !strings.HasSuffix(output, "result: [-2 0 4 7]\n") {
+				t.Errorf("replay output =\n%s\nwant the pivot choice and the sorted result", output)
+			}
+
+			stdout.Reset()
+			if code := cli.Run([]string{"replay", "--trace", path, "--summary"}); code != ExitOK {
+				t.Fatalf("replay --summary returned %d; want %d (stderr: %s)", code, ExitOK, stderr.String())
+			}
+			if output := stdout.String(); !strings.HasPrefix(output, "quick: ") || !strings.Contains(output, "sorted true") {
+				t.Errorf("replay --summary output =\n%s\nwant a sorted quick summary", output)
+			}
+		})
+	}
+
+	unsorted := filepath.Join(dir, "unsorted.jsonl")
+	trace := `{"version":1,"algorithm":"noop","input":[2,1]}` + "\n" + `{"e":"compare","j":1}` + "\n"
+	if err := os.WriteFile(unsorted, []byte(trace), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cli := NewCLI(strings.NewReader(""), &stdout, &stderr)
+	if code := cli.Run([]string{"replay", "--trace", unsorted, "--summary"}); code != ExitVerificationFailed {
+		t.Errorf("replay of an unsorted trace returned %d; want %d (stderr: %s)", code, ExitVerificationFailed, stderr.String())
+	}
+}
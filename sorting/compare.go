@@ -0,0 +1,163 @@
+package sorting
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/JoaoVitor615/algorithms-in-go/pkg"
+)
+
+// CompareOptions selects the algorithms and inputs raced by UseCase.Compare
+type CompareOptions struct {
+	Algorithms    []string           // IDs or names of the algorithms to race, at least one
+	Sizes         []int              // input sizes, one dataset is generated per size and distribution
+	Distributions []pkg.Distribution // input shapes, Uniform when empty
+	Runs          int                // timed runs per algorithm on each dataset
+	WarmupRuns    int                // untimed runs per algorithm before the timed ones
+}
+
+// ComparisonEntry is the outcome of one algorithm on one dataset
+type ComparisonEntry struct {
+	Algorithm string            `json:"algorithm"`
+	Name      string            `json:"name"`
+	Rank      int               `json:"rank"` // 1 for the fastest median
+	Stats     pkg.DurationStats `json:"stats"`
+	Relative  float64           `json:"relative"` // median divided by the fastest median
+	IsSorted  bool              `json:"sorted"`
+	Matches   bool              `json:"matches"` // output equal to the reference sort of the dataset
+}
+
+// ComparisonCase holds the ranking of every algorithm on one dataset
+type ComparisonCase struct {
+	Count        int               `json:"count"`
+	Distribution pkg.Distribution  `json:"distribution"`
+	Entries      []ComparisonEntry `json:"entries"` // fastest first
+	Agree        bool              `json:"agree"`   // every output matches the reference
+}
+
+// Comparison is the result of racing several algorithms on identical inputs
+type Comparison struct {
+	Environment pkg.Environment  `json:"environment"`
+	Runs        int              `json:"runs"`
+	WarmupRuns  int              `json:"warmup_runs"`
+	Cases       []ComparisonCase `json:"cases"`
+}
+
+// Agree reports whether every algorithm produced the reference output in every case
+func (c Comparison) Agree() bool {
+	for _, comparisonCase := range c.Cases {
+		if !comparisonCase.Agree {
+			return false
+		}
+	}
+	return true
+}
+
+// Compare races the selected algorithms on one generated dataset per size and distribution
+// Every algorithm sorts its own copy of the same dataset, and the runs of the algorithms are
+// interleaved so that a slowdown of the machine affects all of them alike
+func (uc *UseCase) Compare(options CompareOptions) (Comparison, error) {
+	if len(options.Algorithms) == 0 {
+		return Comparison{}, errors.New("select at least one algorithm to compare")
+	}
+	if options.Runs < 1 {
+		return Comparison{}, fmt.Errorf("runs must be at least 1, got %d", options.Runs)
+	}
+	if options.WarmupRuns < 0 {
+		return Comparison{}, fmt.Errorf("warm-up runs cannot be negative, got %d", options.WarmupRuns)
+	}
+
+	algorithms := make([]Algorithm, 0, len(options.Algorithms))
+	for _, name := range options.Algorithms {
+		algorithm, err := uc.registry.Lookup(name)
+		if err != nil {
+			return Comparison{}, err
+		}
+		algorithms = append(algorithms, algorithm)
+	}
+
+	distributions := options.Distributions
+	if len(distributions) == 0 {
+		distributions = []pkg.Distribution{pkg.Uniform}
+	}
+
+	comparison := Comparison{
+		Environment: pkg.CaptureEnvironment(uc.generator.Seed()),
+		Runs:        options.Runs,
+		WarmupRuns:  options.WarmupRuns,
+	}
+
+	for _, distribution := range distributions {
+		for _, count := range options.Sizes {
+			if count < 1 {
+				return Comparison{}, fmt.Errorf("comparison size must be at least 1, got %d", count)
+			}
+
+			numbers := uc.generator.GenerateDistribution(distribution, count)
+			comparisonCase := uc.compareOn(algorithms, numbers, options.Runs, options.WarmupRuns)
+			comparisonCase.Distribution = distribution
+			comparison.Cases = append(comparison.Cases, comparisonCase)
+		}
+	}
+
+	return comparison, nil
+}
+
+// compareOn times every algorithm on copies of numbers and ranks them by median duration
+func (uc *UseCase) compareOn(algorithms []Algorithm, numbers []int, runs, warmupRuns int) ComparisonCase {
+	reference := slices.Clone(numbers)
+	slices.Sort(reference)
+
+	for run := 0; run < warmupRuns; run++ {
+		for _, algorithm := range algorithms {
+			uc.timeSort(algorithm, numbers)
+		}
+	}
+
+	samples := make([][]time.Duration, len(algorithms))
+	isSorted := make([]bool, len(algorithms))
+	matches := make([]bool, len(algorithms))
+	for i := range algorithms {
+		isSorted[i], matches[i] = true, true
+	}
+
+	for run := 0; run < runs; run++ {
+		for i, algorithm := range algorithms {
+			duration, sorted := uc.timeSort(algorithm, numbers)
+			samples[i] = append(samples[i], duration)
+			isSorted[i] = isSorted[i] && pkg.IsSortedSlice(sorted)
+			matches[i] = matches[i] && slices.Equal(sorted, reference)
+		}
+	}
+
+	comparisonCase := ComparisonCase{Count: len(numbers), Agree: true}
+	for i, algorithm := range algorithms {
+		comparisonCase.Entries = append(comparisonCase.Entries, ComparisonEntry{
+			Algorithm: algorithm.ID,
+			Name:      algorithm.Name,
+			Stats:     pkg.CalculateDurationStats(samples[i]),
+			IsSorted:  isSorted[i],
+			Matches:   matches[i],
+		})
+		comparisonCase.Agree = comparisonCase.Agree && matches[i]
+	}
+
+	slices.SortStableFunc(comparisonCase.Entries, func(a, b ComparisonEntry) int {
+		return cmp.Compare(a.Stats.Median, b.Stats.Median)
+	})
+
+	fastest := comparisonCase.Entries[0].Stats.Median
+	for i := range comparisonCase.Entries {
+		entry := &comparisonCase.Entries[i]
+		entry.Rank = i + 1
+		entry.Relative = 1
+		if fastest > 0 {
+			entry.Relative = float64(entry.Stats.Median) / float64(fastest)
+		}
+	}
+
+	return comparisonCase
+}
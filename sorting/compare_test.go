@@ -0,0 +1,157 @@
+package sorting
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/JoaoVitor615/algorithms-in-go/pkg"
+	"github.com/JoaoVitor615/algorithms-in-go/sorting/quick_sort"
+)
+
+// TestCompare tests that every case ranks all algorithms by median and that correct sorts agree
+func TestCompare(t *testing.T) {
+	useCase := NewUseCaseWithSeed(3)
+	options := CompareOptions{
+		Algorithms:    []string{"merge", "quick", "bubble", "heap", "insertion"},
+		Sizes:         []int{50, 200},
+		Distributions: []pkg.Distribution{pkg.Uniform, pkg.FewUnique},
+		Runs:          3,
+		WarmupRuns:    1,
+	}
+
+	comparison, err := useCase.Compare(options)
+	if err != nil {
+		t.Fatalf("Compare returned unexpected error: %v", err)
+	}
+
+	if len(comparison.Cases) != len(options.Sizes)*len(options.Distributions) {
+		t.Fatalf("len(Cases) = %d; want %d", len(comparison.Cases), len(options.Sizes)*len(options.Distributions))
+	}
+	if !comparison.Agree() {
+		t.Error("Agree() = false; want true for correct algorithms")
+	}
+
+	for _, comparisonCase := range comparison.Cases {
+		if len(comparisonCase.Entries) != len(options.Algorithms) {
+			t.Fatalf("case %d/%s has %d entries; want %d", comparisonCase.Count, comparisonCase.Distribution, len(comparisonCase.Entries), len(options.Algorithms))
+		}
+
+		for i, entry := range comparisonCase.Entries {
+			if entry.Rank != i+1 {
+				t.Errorf("Entries[%d].Rank = %d; want %d", i, entry.Rank, i+1)
+			}
+			if i > 0 && entry.Stats.Median < comparisonCase.Entries[i-1].Stats.Median {
+				t.Errorf("Entries[%d] is faster than Entries[%d]", i, i-1)
+			}
+			if entry.Stats.Runs != options.Runs || !entry.IsSorted || !entry.Matches {
+				t.Errorf("Entries[%d] = %+v; want %d sorted, matching runs", i, entry, options.Runs)
+			}
+		}
+		if comparisonCase.Entries[0].Relative != 1 {
+			t.Errorf("fastest Relative = %.2f; want 1", comparisonCase.Entries[0].Relative)
+		}
+	}
+}
+
+// TestCompareAllAlgorithms races every registered algorithm, including the non-comparison sorts,
+// on the distributions they find hardest and checks that they all agree
+func TestCompareAllAlgorithms(t *testing.T) {
+	useCase := NewUseCaseWithSeed(5)
+
+	var ids []string
+	for _, algorithm := range useCase.registry.All() {
+		ids = append(ids, algorithm.ID)
+	}
+
+	comparison, err := useCase.Compare(CompareOptions{
+		Algorithms:    ids,
+		Sizes:         []int{300},
+		Distributions: []pkg.Distribution{pkg.Uniform, pkg.AllEqual, pkg.Zipf},
+		Runs:          1,
+	})
+	if err != nil {
+		t.Fatalf("Compare returned unexpected error: %v", err)
+	}
+
+	for _, comparisonCase := range comparison.Cases {
+		for _, entry := range comparisonCase.Entries {
+			if !entry.Matches {
+				t.Errorf("%s did not match the reference on %s input", entry.Algorithm, comparisonCase.Distribution)
+			}
+		}
+	}
+}
+
+// TestCompareDetectsDisagreement tests that an algorithm dropping elements is reported even though its output is sorted
+func TestCompareDetectsDisagreement(t *testing.T) {
+	useCase := NewUseCaseWithSeed(3)
+	dropMax := Algorithm{
+		ID:         "drop",
+		Name:       "Drop Max",
+		Complexity: Complexity{Average: "O(n log n)"},
+		Kind:       ArrayAlgorithm,
+		Sorter: pkg.SorterFunc(func(a []int) {
+			quick_sort.QuickSortInPlace(a)
+			a[len(a)-1] = a[len(a)-2]
+		}),
+	}
+	if err := useCase.registry.Register(dropMax); err != nil {
+		t.Fatal(err)
+	}
+
+	comparison, err := useCase.Compare(CompareOptions{Algorithms: []string{"quick", "drop"}, Sizes: []int{100}, Runs: 1})
+	if err != nil {
+		t.Fatalf("Compare returned unexpected error: %v", err)
+	}
+
+	if comparison.Agree() {
+		t.Error("Agree() = true; want false when an algorithm drops an element")
+	}
+	for _, entry := range comparison.Cases[0].Entries {
+		if entry.Algorithm == "drop" && (entry.Matches || !entry.IsSorted) {
+			t.Errorf("drop entry = %+v; want sorted but not matching", entry)
+		}
+	}
+}
+
+// TestCompareInvalidOptions tests that empty selections, unknown algorithms and invalid runs are rejected
+func TestCompareInvalidOptions(t *testing.T) {
+	useCase := NewUseCase()
+
+	testCases := []struct {
+		name    string
+		options CompareOptions
+	}{
+		{name: "No algorithms", options: CompareOptions{Sizes: []int{10}, Runs: 1}},
+		{name: "Unknown algorithm", options: CompareOptions{Algorithms: []string{"bogo"}, Sizes: []int{10}, Runs: 1}},
+		{name: "Zero runs", options: CompareOptions{Algorithms: []string{"quick"}, Sizes: []int{10}}},
+		{name: "Zero size", options: CompareOptions{Algorithms: []string{"quick"}, Sizes: []int{0}, Runs: 1}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := useCase.Compare(tc.options); err == nil {
+				t.Error("Compare returned no error")
+			}
+		})
+	}
+}
+
+// TestParseAlgorithmSelection tests menu selections of algorithms
+func TestParseAlgorithmSelection(t *testing.T) {
+	algorithms := DefaultRegistry().All()
+
+	all, err := parseAlgorithmSelection("", algorithms)
+	if err != nil || len(all) != len(algorithms) {
+		t.Errorf("parseAlgorithmSelection(\"\") = %v, %v; want every algorithm", all, err)
+	}
+
+	selected, err := parseAlgorithmSelection("2, 1", algorithms)
+	if err != nil || !reflect.DeepEqual(selected, []string{algorithms[1].ID, algorithms[0].ID}) {
+		t.Errorf("parseAlgorithmSelection(\"2, 1\") = %v, %v", selected, err)
+	}
+
+	if _, err := parseAlgorithmSelection("0", algorithms); err == nil {
+		t.Error("parseAlgorithmSelection(\"0\") returned no error")
+	}
+}
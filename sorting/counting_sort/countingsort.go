@@ -0,0 +1,152 @@
+package counting_sort
+
+import (
+	"slices"
+
+	"github.com/JoaoVitor615/algorithms-in-go/pkg"
+	"github.com/JoaoVitor615/algorithms-in-go/sorting/radix_sort"
+)
+
+// Counting Sort allocates one counter per possible key, so a sparse range of keys would need
+// more memory than the input itself; ranges wider than countsPerElement counters per element,
+// and at least minCountsLimit, are sorted with LSD Radix Sort instead
+const (
+	countsPerElement = 4
+	minCountsLimit   = 1 << 16
+)
+
+// CountingSort sorts an array of integers by counting the occurrences of each value
+// Negative numbers are supported, the counts are offset by the minimum value
+// Inputs whose range is too wide for a table of counts fall back to LSD Radix Sort
+// Time Complexity: O(n + k), where k is the range between the minimum and maximum values
+// Space Complexity: O(n + k)
+func CountingSort(arr []int) []int {
+	return CountingSortByKey(arr, identity)
+}
+
+// CountingSortInstrumented sorts an array like CountingSort and records the
+// element writes and allocations it performs in counter
+// Counting sort never compares two elements, so no comparisons are recorded
+func CountingSortInstrumented(arr []int, counter *pkg.OperationCounter) []int {
+	if len(arr) <= 1 {
+		return slices.Clone(arr)
+	}
+
+	result := make([]int, len(arr))
+	counter.Allocate(len(result))
+
+	countingSort(arr, result, identity, counter)
+	return result
+}
+
+// CountingSortInPlace sorts an array using Counting Sort and writes the result back into arr
+// It still needs O(n + k) extra memory for the counts and the output
+func CountingSortInPlace(arr []int) {
+	if len(arr) <= 1 {
+		return
+	}
+
+	copy(arr, CountingSort(arr))
+}
+
+// NewSorter returns a pkg.Sorter that runs CountingSort and records the element writes
+// and allocations it performs in counter
+// Sort writes straight into dst, SortInPlace needs an output buffer and copies it back into arr
+// counter may be nil when the caller does not need operation counts
+func NewSorter(counter *pkg.OperationCounter) pkg.Sorter {
+	return sorter{counter: counter}
+}
+
+// sorter implements pkg.Sorter with Counting Sort, which reads src and writes dst in separate slices
+type sorter struct {
+	counter *pkg.OperationCounter
+}
+
+// Sort writes the elements of src into dst in ascending order
+func (s sorter) Sort(dst, src []int) {
+	dst = pkg.SortDestination(dst, src)
+	if len(src) <= 1 || pkg.SameSlice(dst, src) {
+		copy(dst, src)
+		s.SortInPlace(dst)
+		return
+	}
+
+	countingSort(src, dst, identity, s.counter)
+}
+
+// SortInPlace sorts arr through an output buffer
+func (s sorter) SortInPlace(arr []int) {
+	if len(arr) <= 1 {
+		return
+	}
+
+	result := make([]int, len(arr))
+	s.counter.Allocate(len(result))
+
+	countingSort(arr, result, identity, s.counter)
+	copy(arr, result)
+	s.counter.Write(len(arr))
+}
+
+// CountingSortByKey sorts a slice of any type by an integer key
+// It returns a sorted copy and leaves the original slice untouched
+// Elements with equal keys keep their original relative order
+func CountingSortByKey[T any](arr []T, key func(T) int) []T {
+	if len(arr) <= 1 {
+		return slices.Clone(arr)
+	}
+
+	result := make([]T, len(arr))
+	countingSort(arr, result, key, nil)
+	return result
+}
+
+// countingSort writes the elements of src into dst ordered by key
+// When the range of keys is wider than countsLimit allows, dst is sorted with LSD Radix Sort instead
+// counter may be nil when the caller does not need operation counts
+func countingSort[T any](src, dst []T, key func(T) int, counter *pkg.OperationCounter) {
+	low, high := key(src[0]), key(src[0])
+	for _, element := range src[1:] {
+		k := key(element)
+		low = min(low, k)
+		high = max(high, k)
+	}
+
+	// high - low may overflow int, but as uint64 it is exact since low <= high
+	if uint64(high)-uint64(low) >= countsLimit(len(src)) {
+		copy(dst, src)
+		counter.Write(len(dst))
+		radix_sort.RadixSortLSDInPlaceByKeyInstrumented(dst, key, counter)
+		return
+	}
+
+	counts := make([]int, high-low+1)
+	counter.Allocate(len(counts))
+
+	for _, element := range src {
+		counts[uint(key(element)-low)]++
+	}
+
+	// Turn the counts into the index one past the last slot of each key
+	for i := 1; i < len(counts); i++ {
+		counts[i] += counts[i-1]
+	}
+
+	// Walking backwards places equal keys from the last slot down, keeping them stable
+	for i := len(src) - 1; i >= 0; i-- {
+		slot := uint(key(src[i]) - low)
+		counts[slot]--
+		dst[counts[slot]] = src[i]
+		counter.Write(1)
+	}
+}
+
+// countsLimit returns the number of counters Counting Sort may allocate for n elements
+func countsLimit(n int) uint64 {
+	return uint64(max(countsPerElement*n, minCountsLimit))
+}
+
+// identity is the key of an int sorted by its own value
+func identity(x int) int {
+	return x
+}
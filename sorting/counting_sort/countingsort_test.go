@@ -0,0 +1,266 @@
+package counting_sort
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/JoaoVitor615/algorithms-in-go/pkg"
+)
+
+// countingSortTestCases is the shared table used by the int and generic CountingSort tests.
+var countingSortTestCases = []struct {
+	name     string
+	input    []int
+	expected []int
+}{
+	{
+		name:     "Empty array",
+		input:    []int{},
+		expected: []int{},
+	},
+	{
+		name:     "Single element",
+		input:    []int{5},
+		expected: []int{5},
+	},
+	{
+		name:     "Already sorted array",
+		input:    []int{1, 2, 3, 4, 5},
+		expected: []int{1, 2, 3, 4, 5},
+	},
+	{
+		name:     "Reverse sorted array",
+		input:    []int{5, 4, 3, 2, 1},
+		expected: []int{1, 2, 3, 4, 5},
+	},
+	{
+		name:     "Unsorted array with even number of elements",
+		input:    []int{4, 2, 5, 1, 3, 6},
+		expected: []int{1, 2, 3, 4, 5, 6},
+	},
+	{
+		name:     "Array with duplicate elements",
+		input:    []int{4, 2, 5, 1, 3, 2, 4},
+		expected: []int{1, 2, 2, 3, 4, 4, 5},
+	},
+	{
+		name:     "Array with all same elements",
+		input:    []int{3, 3, 3, 3, 3},
+		expected: []int{3, 3, 3, 3, 3},
+	},
+	{
+		name:     "Array with negative numbers",
+		input:    []int{-5, 2, -3, 8, 1, -1},
+		expected: []int{-5, -3, -1, 1, 2, 8},
+	},
+	{
+		name:     "Large random array",
+		input:    []int{64, 34, 25, 12, 22, 11, 90, 88, 76, 50, 42},
+		expected: []int{11, 12, 22, 25, 34, 42, 50, 64, 76, 88, 90},
+	},
+}
+
+// TestCountingSort runs the shared table against every variant
+func TestCountingSort(t *testing.T) {
+	sorts := []struct {
+		name string
+		sort func([]int) []int
+	}{
+		{"CountingSort", CountingSort},
+		{"CountingSortInstrumented", func(arr []int) []int { return CountingSortInstrumented(arr, pkg.NewOperationCounter()) }},
+		{"CountingSortByKey", func(arr []int) []int { return CountingSortByKey(arr, func(x int) int { return x }) }},
+		{"CountingSortInPlace", func(arr []int) []int {
+			result := slices.Clone(arr)
+			CountingSortInPlace(result)
+			return result
+		}},
+	}
+
+	for _, variant := range sorts {
+		for _, tc := range countingSortTestCases {
+			t.Run(variant.name+"/"+tc.name, func(t *testing.T) {
+				input := slices.Clone(tc.input)
+				result := variant.sort(input)
+
+				if !reflect.DeepEqual(result, tc.expected) {
+					t.Errorf("%s(%v) = %v; want %v", variant.name, tc.input, result, tc.expected)
+				}
+				if variant.name != "CountingSortInPlace" && !reflect.DeepEqual(input, tc.input) {
+					t.Errorf("%s modified its input: got %v, want %v", variant.name, input, tc.input)
+				}
+			})
+		}
+	}
+}
+
+// TestCountingSortDistributions checks CountingSort against slices.Sort on bounded inputs
+func TestCountingSortDistributions(t *testing.T) {
+	generator := pkg.NewRandomGeneratorWithSeed(42)
+
+	for _, distribution := range pkg.Distributions() {
+		for _, size := range []int{2, 100, 10000} {
+			t.Run(fmt.Sprintf("%v/%d", distribution, size), func(t *testing.T) {
+				input := generator.GenerateDistribution(distribution, size)
+				expected := slices.Clone(input)
+				slices.Sort(expected)
+
+				if result := CountingSort(input); !reflect.DeepEqual(result, expected) {
+					t.Errorf("CountingSort did not sort %d %v numbers", size, distribution)
+				}
+			})
+		}
+	}
+
+	t.Run("Negative range", func(t *testing.T) {
+		input := generator.GenerateIntSlice(5000, -2500, 2500)
+		expected := slices.Clone(input)
+		slices.Sort(expected)
+
+		if result := CountingSort(input); !reflect.DeepEqual(result, expected) {
+			t.Error("CountingSort did not sort numbers between -2500 and 2500")
+		}
+	})
+}
+
+// TestCountingSortStability sorts tagged records by key and checks that equal keys keep their order
+func TestCountingSortStability(t *testing.T) {
+	type record struct {
+		key   int
+		label string
+	}
+	input := []record{{3, "a"}, {-1, "b"}, {3, "c"}, {2, "d"}, {3, "e"}, {-1, "f"}, {2, "g"}}
+	expected := []record{{-1, "b"}, {-1, "f"}, {2, "d"}, {2, "g"}, {3, "a"}, {3, "c"}, {3, "e"}}
+
+	result := CountingSortByKey(input, func(r record) int { return r.key })
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("CountingSortByKey(%v) = %v; want %v", input, result, expected)
+	}
+
+	if !slices.IsSortedFunc(result, func(a, b record) int { return cmp.Compare(a.key, b.key) }) {
+		t.Errorf("CountingSortByKey(%v) is not sorted by key", input)
+	}
+}
+
+// TestCountingSortInstrumented tests the operation counts of the instrumented version
+func TestCountingSortInstrumented(t *testing.T) {
+	testCases := []struct {
+		name              string
+		input             []int
+		allocatedElements int64
+	}{
+		{"Range as wide as the input", []int{5, 4, 3, 2, 1}, 10},
+		{"Range wider than the input", []int{100, 1, 50, 1, 75}, 105},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			counter := pkg.NewOperationCounter()
+			result := CountingSortInstrumented(tc.input, counter)
+
+			if !reflect.DeepEqual(result, CountingSort(tc.input)) {
+				t.Errorf("CountingSortInstrumented(%v) = %v; want sorted output", tc.input, result)
+			}
+			if counter.Comparisons != 0 || counter.Writes != int64(len(tc.input)) {
+				t.Errorf("counted %d comparisons and %d writes; want 0 and %d", counter.Comparisons, counter.Writes, len(tc.input))
+			}
+			if counter.Allocations != 2 || counter.AllocatedElements != tc.allocatedElements {
+				t.Errorf("counted %d allocations of %d elements; want 2 of %d",
+					counter.Allocations, counter.AllocatedElements, tc.allocatedElements)
+			}
+		})
+	}
+}
+
+// TestCountingSortWideRange tests that ranges too wide for a table of counts, including ranges
+// whose width overflows int, are sorted by the radix fallback instead of allocating the table
+func TestCountingSortWideRange(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    []int
+		expected []int
+	}{
+		{"Sparse range", []int{0, 100000000000000}, []int{0, 100000000000000}},
+		{"Sparse range reversed", []int{100000000000000, -7, 0}, []int{-7, 0, 100000000000000}},
+		{"Full int range", []int{math.MinInt, math.MaxInt, 5}, []int{math.MinInt, 5, math.MaxInt}},
+		{"Full int range with duplicates", []int{math.MaxInt, math.MinInt, math.MaxInt, 0}, []int{math.MinInt, 0, math.MaxInt, math.MaxInt}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if result := CountingSort(tc.input); !reflect.DeepEqual(result, tc.expected) {
+				t.Errorf("CountingSort(%v) = %v; want %v", tc.input, result, tc.expected)
+			}
+
+			counter := pkg.NewOperationCounter()
+			if result := CountingSortInstrumented(tc.input, counter); !reflect.DeepEqual(result, tc.expected) {
+				t.Errorf("CountingSortInstrumented(%v) = %v; want %v", tc.input, result, tc.expected)
+			}
+			if counter.AllocatedElements > int64(countsLimit(len(tc.input))) {
+				t.Errorf("allocated %d elements; want at most %d", counter.AllocatedElements, countsLimit(len(tc.input)))
+			}
+
+			arr := slices.Clone(tc.input)
+			NewSorter(nil).SortInPlace(arr)
+			if !reflect.DeepEqual(arr, tc.expected) {
+				t.Errorf("SortInPlace(%v) = %v; want %v", tc.input, arr, tc.expected)
+			}
+		})
+	}
+
+	// The fallback must stay stable, like the counting pass it replaces
+	type record struct {
+		key   int
+		order int
+	}
+	records := []record{{math.MaxInt, 0}, {math.MinInt, 1}, {math.MaxInt, 2}, {math.MinInt, 3}}
+	expected := []record{{math.MinInt, 1}, {math.MinInt, 3}, {math.MaxInt, 0}, {math.MaxInt, 2}}
+	if result := CountingSortByKey(records, func(r record) int { return r.key }); !reflect.DeepEqual(result, expected) {
+		t.Errorf("CountingSortByKey(%v) = %v; want %v", records, result, expected)
+	}
+}
+
+// BenchmarkCountingSort compares CountingSort with slices.Sort on the default 1-1000 range
+func BenchmarkCountingSort(b *testing.B) {
+	generator := pkg.NewRandomGeneratorWithSeed(42)
+
+	for _, size := range []int{1000, 100000, 1000000} {
+		input := generator.GenerateIntSliceDefault(size)
+
+		b.Run(fmt.Sprintf("CountingSort/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				CountingSort(input)
+			}
+		})
+		b.Run(fmt.Sprintf("slices.Sort/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				slices.Sort(slices.Clone(input))
+			}
+		})
+	}
+}
+
+// FuzzCountingSort checks the sort properties of every Counting Sort variant on fuzzed inputs
+func FuzzCountingSort(f *testing.F) {
+	for _, tc := range countingSortTestCases {
+		f.Add(pkg.BytesFromInts(tc.input))
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		input := pkg.IntsFromBytes(data)
+		checks := map[string]error{
+			"CountingSort":        pkg.CheckSort(CountingSort, input),
+			"CountingSortByKey":   pkg.CheckSort(func(arr []int) []int { return CountingSortByKey(arr, identity) }, input),
+			"CountingSortInPlace": pkg.CheckSortInPlace(CountingSortInPlace, input),
+			"NewSorter":           pkg.CheckSorter(NewSorter(pkg.NewOperationCounter()), input),
+		}
+		for name, err := range checks {
+			if err != nil {
+				t.Errorf("%s: %v", name, err)
+			}
+		}
+	})
+}
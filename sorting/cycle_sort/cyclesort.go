@@ -0,0 +1,119 @@
+package cycle_sort
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/JoaoVitor615/algorithms-in-go/pkg"
+)
+
+// CycleSort sorts an array using the Cycle Sort algorithm
+// Every element is written directly to its final position, so an element that is
+// already in place is never written and each misplaced element is written exactly once
+// Time Complexity: O(n²) in every case
+// Space Complexity: O(1)
+func CycleSort(arr []int) []int {
+	return CycleSortOrdered(arr)
+}
+
+// CycleSortInstrumented sorts an array like CycleSort and records
+// the comparisons, writes and allocations it performs in counter
+func CycleSortInstrumented(arr []int, counter *pkg.OperationCounter) []int {
+	if len(arr) <= 1 {
+		return slices.Clone(arr)
+	}
+
+	result := make([]int, len(arr))
+	copy(result, arr)
+	counter.Allocate(len(result))
+
+	cycleSort(result, pkg.CountComparisons(counter, cmp.Compare[int]), counter)
+	return result
+}
+
+// CycleSortInPlace sorts an array in-place using the Cycle Sort algorithm
+func CycleSortInPlace(arr []int) {
+	CycleSortInPlaceFunc(arr, cmp.Compare[int])
+}
+
+// NewSorter returns a pkg.Sorter that runs CycleSort and records the operations it performs in counter
+// counter may be nil when the caller does not need operation counts
+func NewSorter(counter *pkg.OperationCounter) pkg.Sorter {
+	return pkg.SorterFunc(func(arr []int) {
+		if len(arr) <= 1 {
+			return
+		}
+		cycleSort(arr, pkg.CountComparisons(counter, cmp.Compare[int]), counter)
+	})
+}
+
+// CycleSortOrdered sorts a slice of any ordered type (integers, floats, strings)
+// It returns a sorted copy and leaves the original slice untouched
+func CycleSortOrdered[T cmp.Ordered](arr []T) []T {
+	return CycleSortFunc(arr, cmp.Compare[T])
+}
+
+// CycleSortFunc sorts a slice of any type using a comparator function
+// The comparator must return a negative number when a < b, zero when a == b
+// and a positive number when a > b, matching the contract of cmp.Compare
+func CycleSortFunc[T any](arr []T, compare func(a, b T) int) []T {
+	if len(arr) <= 1 {
+		return slices.Clone(arr)
+	}
+
+	// Make a copy to avoid modifying the original array
+	result := make([]T, len(arr))
+	copy(result, arr)
+
+	cycleSort(result, compare, nil)
+	return result
+}
+
+// CycleSortInPlaceFunc sorts a slice in-place using a comparator function
+func CycleSortInPlaceFunc[T any](arr []T, compare func(a, b T) int) {
+	cycleSort(arr, compare, nil)
+}
+
+// cycleSort performs the Cycle Sort in-place
+// The permutation that sorts the array is split into cycles, and each cycle is rotated
+// by carrying one element at a time to the position given by the number of smaller elements
+// counter may be nil when the caller does not need operation counts
+func cycleSort[T any](arr []T, compare func(a, b T) int, counter *pkg.OperationCounter) {
+	n := len(arr)
+
+	for start := 0; start < n-1; start++ {
+		item := arr[start]
+
+		pos := position(arr, start, item, compare)
+		if pos == start {
+			continue
+		}
+
+		// Rotate the cycle until an element belonging at start is found
+		for pos != start {
+			arr[pos], item = item, arr[pos]
+			counter.Write(1)
+
+			pos = position(arr, start, item, compare)
+		}
+
+		arr[start] = item
+		counter.Write(1)
+	}
+}
+
+// position returns where item belongs among arr[start:]: after every smaller element
+// and after the equal elements already placed there
+func position[T any](arr []T, start int, item T, compare func(a, b T) int) int {
+	pos := start
+	for i := start + 1; i < len(arr); i++ {
+		if compare(arr[i], item) < 0 {
+			pos++
+		}
+	}
+
+	for pos != start && compare(item, arr[pos]) == 0 {
+		pos++
+	}
+	return pos
+}
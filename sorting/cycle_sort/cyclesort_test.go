@@ -0,0 +1,245 @@
+package cycle_sort
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/JoaoVitor615/algorithms-in-go/pkg"
+)
+
+// cycleSortTestCases is the shared table used by the int and generic CycleSort tests.
+var cycleSortTestCases = []struct {
+	name     string
+	input    []int
+	expected []int
+}{
+	{
+		name:     "Empty array",
+		input:    []int{},
+		expected: []int{},
+	},
+	{
+		name:     "Single element",
+		input:    []int{5},
+		expected: []int{5},
+	},
+	{
+		name:     "Already sorted array",
+		input:    []int{1, 2, 3, 4, 5},
+		expected: []int{1, 2, 3, 4, 5},
+	},
+	{
+		name:     "Reverse sorted array",
+		input:    []int{5, 4, 3, 2, 1},
+		expected: []int{1, 2, 3, 4, 5},
+	},
+	{
+		name:     "Unsorted array with even number of elements",
+		input:    []int{4, 2, 5, 1, 3, 6},
+		expected: []int{1, 2, 3, 4, 5, 6},
+	},
+	{
+		name:     "Array with duplicate elements",
+		input:    []int{4, 2, 5, 1, 3, 2, 4},
+		expected: []int{1, 2, 2, 3, 4, 4, 5},
+	},
+	{
+		name:     "Array with all same elements",
+		input:    []int{3, 3, 3, 3, 3},
+		expected: []int{3, 3, 3, 3, 3},
+	},
+	{
+		name:     "Array with negative numbers",
+		input:    []int{-5, 2, -3, 8, 1, -1},
+		expected: []int{-5, -3, -1, 1, 2, 8},
+	},
+	{
+		name:     "Large random array",
+		input:    []int{64, 34, 25, 12, 22, 11, 90, 88, 76, 50, 42},
+		expected: []int{11, 12, 22, 25, 34, 42, 50, 64, 76, 88, 90},
+	},
+}
+
+// TestCycleSort runs the shared table against every variant
+func TestCycleSort(t *testing.T) {
+	sorts := []struct {
+		name string
+		sort func([]int) []int
+	}{
+		{"CycleSort", CycleSort},
+		{"CycleSortInstrumented", func(arr []int) []int { return CycleSortInstrumented(arr, pkg.NewOperationCounter()) }},
+		{"CycleSortOrdered", CycleSortOrdered[int]},
+		{"CycleSortFunc", func(arr []int) []int { return CycleSortFunc(arr, cmp.Compare[int]) }},
+		{"CycleSortInPlace", func(arr []int) []int {
+			result := slices.Clone(arr)
+			CycleSortInPlace(result)
+			return result
+		}},
+	}
+
+	for _, variant := range sorts {
+		for _, tc := range cycleSortTestCases {
+			t.Run(variant.name+"/"+tc.name, func(t *testing.T) {
+				input := slices.Clone(tc.input)
+				result := variant.sort(input)
+
+				if !reflect.DeepEqual(result, tc.expected) {
+					t.Errorf("%s(%v) = %v; want %v", variant.name, tc.input, result, tc.expected)
+				}
+				if variant.name != "CycleSortInPlace" && !reflect.DeepEqual(input, tc.input) {
+					t.Errorf("%s modified its input: got %v, want %v", variant.name, input, tc.input)
+				}
+			})
+		}
+	}
+}
+
+// TestCycleSortMinimumWrites checks that every misplaced element is written exactly once
+// and elements already in their final position are never written, duplicates included
+func TestCycleSortMinimumWrites(t *testing.T) {
+	generator := pkg.NewRandomGeneratorWithSeed(42)
+
+	for _, distribution := range pkg.Distributions() {
+		for _, size := range []int{2, 3, 50, 500} {
+			t.Run(fmt.Sprintf("%v/%d", distribution, size), func(t *testing.T) {
+				input := generator.GenerateDistribution(distribution, size)
+				expected := slices.Clone(input)
+				slices.Sort(expected)
+
+				counter := pkg.NewOperationCounter()
+				result := CycleSortInstrumented(input, counter)
+				if !reflect.DeepEqual(result, expected) {
+					t.Fatalf("CycleSortInstrumented did not sort %d %v numbers", size, distribution)
+				}
+
+				misplaced := 0
+				for i := range input {
+					if input[i] != expected[i] {
+						misplaced++
+					}
+				}
+				if counter.Writes != int64(misplaced) || counter.Swaps != 0 {
+					t.Errorf("counted %d writes and %d swaps; want %d writes for the misplaced elements and no swaps",
+						counter.Writes, counter.Swaps, misplaced)
+				}
+			})
+		}
+	}
+}
+
+// TestCycleSortGenericTypes tests the generic variants with floats, strings and a custom order
+func TestCycleSortGenericTypes(t *testing.T) {
+	t.Run("Floats", func(t *testing.T) {
+		input := []float64{3.5, -1.25, 2.0, 0.5, -7.75, 2.0}
+		expected := []float64{-7.75, -1.25, 0.5, 2.0, 2.0, 3.5}
+
+		if result := CycleSortOrdered(input); !reflect.DeepEqual(result, expected) {
+			t.Errorf("CycleSortOrdered(%v) = %v; want %v", input, result, expected)
+		}
+	})
+
+	t.Run("Strings", func(t *testing.T) {
+		input := []string{"pear", "apple", "fig", "banana"}
+		expected := []string{"apple", "banana", "fig", "pear"}
+
+		if result := CycleSortOrdered(input); !reflect.DeepEqual(result, expected) {
+			t.Errorf("CycleSortOrdered(%v) = %v; want %v", input, result, expected)
+		}
+	})
+
+	t.Run("Descending comparator", func(t *testing.T) {
+		input := []int{4, 2, 5, 1, 3}
+		expected := []int{5, 4, 3, 2, 1}
+
+		CycleSortInPlaceFunc(input, func(a, b int) int { return cmp.Compare(b, a) })
+		if !reflect.DeepEqual(input, expected) {
+			t.Errorf("CycleSortInPlaceFunc with descending comparator = %v; want %v", input, expected)
+		}
+	})
+}
+
+// TestCycleSortInstrumented tests the operation counts of the instrumented version
+func TestCycleSortInstrumented(t *testing.T) {
+	testCases := []struct {
+		name        string
+		input       []int
+		comparisons int64
+		writes      int64
+	}{
+		{
+			name:        "Already sorted array is never written",
+			input:       []int{1, 2, 3, 4, 5},
+			comparisons: 10,
+			writes:      0,
+		},
+		{
+			name:        "Reverse sorted array leaves the middle element alone",
+			input:       []int{5, 4, 3, 2, 1},
+			comparisons: 19,
+			writes:      4,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			counter := pkg.NewOperationCounter()
+			result := CycleSortInstrumented(tc.input, counter)
+
+			if !reflect.DeepEqual(result, CycleSort(tc.input)) {
+				t.Errorf("CycleSortInstrumented(%v) = %v; want sorted output", tc.input, result)
+			}
+			if counter.Comparisons != tc.comparisons || counter.Writes != tc.writes {
+				t.Errorf("counted %d comparisons and %d writes; want %d and %d",
+					counter.Comparisons, counter.Writes, tc.comparisons, tc.writes)
+			}
+			if counter.Allocations != 1 {
+				t.Errorf("counted %d allocations; want 1", counter.Allocations)
+			}
+		})
+	}
+}
+
+// BenchmarkCycleSort benchmarks CycleSort on random and nearly sorted inputs
+func BenchmarkCycleSort(b *testing.B) {
+	generator := pkg.NewRandomGeneratorWithSeed(42)
+
+	for _, size := range []int{100, 1000, 5000} {
+		inputs := map[string][]int{
+			"Random":       generator.GenerateIntSliceDefault(size),
+			"NearlySorted": generator.GenerateNearlySortedSlice(size, 1, 1000, size/100+1),
+		}
+
+		for name, input := range inputs {
+			b.Run(fmt.Sprintf("%s/%d", name, size), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					CycleSort(input)
+				}
+			})
+		}
+	}
+}
+
+// FuzzCycleSort checks the sort properties of every Cycle Sort variant on fuzzed inputs
+func FuzzCycleSort(f *testing.F) {
+	for _, tc := range cycleSortTestCases {
+		f.Add(pkg.BytesFromInts(tc.input))
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		input := pkg.IntsFromBytes(data)
+		checks := map[string]error{
+			"CycleSort":        pkg.CheckSort(CycleSort, input),
+			"CycleSortOrdered": pkg.CheckSort(CycleSortOrdered[int], input),
+			"CycleSortInPlace": pkg.CheckSortInPlace(CycleSortInPlace, input),
+			"NewSorter":        pkg.CheckSorter(NewSorter(pkg.NewOperationCounter()), input),
+		}
+		for name, err := range checks {
+			if err != nil {
+				t.Errorf("%s: %v", name, err)
+			}
+		}
+	})
+}
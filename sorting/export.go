@@ -0,0 +1,193 @@
+package sorting
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/JoaoVitor615/algorithms-in-go/pkg"
+)
+
+// sortResultJSON is the serialized form of SortResult, with the sorted values under "values"
+type sortResultJSON struct {
+	Algorithm   string                  `json:"algorithm"`
+	Environment pkg.Environment         `json:"environment"`
+	Count       int                     `json:"count"`
+	DurationNs  int64                   `json:"duration_ns"`
+	Sorted      bool                    `json:"sorted"`
+	Analysis    pkg.PerformanceAnalysis `json:"analysis"`
+	Operations  *pkg.OperationCounter   `json:"operations,omitempty"`
+	Values      []int                   `json:"values"`
+}
+
+// MarshalJSON encodes the result with its sorted values and the duration in nanoseconds
+func (r SortResult) MarshalJSON() ([]byte, error) {
+	return json.Marshal(sortResultJSON{
+		Algorithm:   r.Algorithm,
+		Environment: r.Environment,
+		Count:       r.Count,
+		DurationNs:  r.Duration.Nanoseconds(),
+		Sorted:      r.IsSorted,
+		Analysis:    r.Analysis,
+		Operations:  r.Operations,
+		Values:      r.SortedArray,
+	})
+}
+
+// ExportSortResult writes a sort result in the given format
+// JSON includes the sorted values, CSV and Markdown only the metrics
+func ExportSortResult(w io.Writer, format pkg.ExportFormat, result SortResult) error {
+	switch format {
+	case pkg.ExportJSON:
+		return pkg.WriteJSON(w, result)
+	case pkg.ExportCSV:
+		return writeSortResultCSV(w, result)
+	case pkg.ExportMarkdown:
+		return writeSortResultMarkdown(w, result)
+	default:
+		return fmt.Errorf("unknown export format %q", format)
+	}
+}
+
+func writeSortResultCSV(w io.Writer, result SortResult) error {
+	writer := csv.NewWriter(w)
+
+	header := []string{"algorithm", "class", "count", "duration_ns", "sorted",
+		"comparisons", "swaps", "writes", "max_depth", "allocations", "allocated_elements"}
+	if err := writer.Write(append(header, pkg.EnvironmentCSVHeader...)); err != nil {
+		return err
+	}
+
+	record := []string{
+		result.Algorithm,
+		result.Analysis.Class.String(),
+		strconv.Itoa(result.Count),
+		strconv.FormatInt(result.Duration.Nanoseconds(), 10),
+		strconv.FormatBool(result.IsSorted),
+	}
+
+	// Operation columns stay empty when the run was not instrumented
+	operations := make([]string, 6)
+	if ops := result.Operations; ops != nil {
+		operations = []string{
+			strconv.FormatInt(ops.Comparisons, 10),
+			strconv.FormatInt(ops.Swaps, 10),
+			strconv.FormatInt(ops.Writes, 10),
+			strconv.Itoa(ops.MaxDepth),
+			strconv.FormatInt(ops.Allocations, 10),
+			strconv.FormatInt(ops.AllocatedElements, 10),
+		}
+	}
+
+	record = append(record, operations...)
+	if err := writer.Write(append(record, result.Environment.CSVRecord()...)); err != nil {
+		return err
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func writeSortResultMarkdown(w io.Writer, result SortResult) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "### %s (%s)\n\n", result.Algorithm, result.Analysis.Class)
+	fmt.Fprintf(&b, "Environment: %s\n\n", result.Environment)
+	b.WriteString("| Metric | Value |\n")
+	b.WriteString("|--------|------:|\n")
+	fmt.Fprintf(&b, "| Count | %s |\n", pkg.FormatNumber(result.Count))
+	fmt.Fprintf(&b, "| Duration | %v |\n", result.Duration)
+	fmt.Fprintf(&b, "| Sorted | %t |\n", result.IsSorted)
+	fmt.Fprintf(&b, "| Time per operation | %.2f ns |\n", result.Analysis.TimePerOperation)
+
+	if ops := result.Operations; ops != nil {
+		fmt.Fprintf(&b, "| Comparisons | %s |\n", pkg.FormatNumber(int(ops.Comparisons)))
+		fmt.Fprintf(&b, "| Swaps | %s |\n", pkg.FormatNumber(int(ops.Swaps)))
+		fmt.Fprintf(&b, "| Writes | %s |\n", pkg.FormatNumber(int(ops.Writes)))
+		fmt.Fprintf(&b, "| Recursion depth | %d |\n", ops.MaxDepth)
+		fmt.Fprintf(&b, "| Allocations | %s |\n", pkg.FormatNumber(int(ops.Allocations)))
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// ExportComparison writes a comparison in the given format
+// JSON keeps the full structure, CSV has one row per algorithm and case, Markdown one ranking table per case
+func ExportComparison(w io.Writer, format pkg.ExportFormat, comparison Comparison) error {
+	switch format {
+	case pkg.ExportJSON:
+		return pkg.WriteJSON(w, comparison)
+	case pkg.ExportCSV:
+		return writeComparisonCSV(w, comparison)
+	case pkg.ExportMarkdown:
+		return writeComparisonMarkdown(w, comparison)
+	default:
+		return fmt.Errorf("unknown export format %q", format)
+	}
+}
+
+func writeComparisonCSV(w io.Writer, comparison Comparison) error {
+	writer := csv.NewWriter(w)
+
+	header := []string{"distribution", "count", "rank", "algorithm", "runs",
+		"median_ns", "mean_ns", "stddev_ns", "p95_ns", "relative", "sorted", "matches"}
+	if err := writer.Write(append(header, pkg.EnvironmentCSVHeader...)); err != nil {
+		return err
+	}
+
+	for _, comparisonCase := range comparison.Cases {
+		for _, entry := range comparisonCase.Entries {
+			record := []string{
+				comparisonCase.Distribution.String(),
+				strconv.Itoa(comparisonCase.Count),
+				strconv.Itoa(entry.Rank),
+				entry.Algorithm,
+				strconv.Itoa(entry.Stats.Runs),
+				strconv.FormatInt(entry.Stats.Median.Nanoseconds(), 10),
+				strconv.FormatInt(entry.Stats.Mean.Nanoseconds(), 10),
+				strconv.FormatInt(entry.Stats.StdDev.Nanoseconds(), 10),
+				strconv.FormatInt(entry.Stats.P95.Nanoseconds(), 10),
+				strconv.FormatFloat(entry.Relative, 'f', 4, 64),
+				strconv.FormatBool(entry.IsSorted),
+				strconv.FormatBool(entry.Matches),
+			}
+			if err := writer.Write(append(record, comparison.Environment.CSVRecord()...)); err != nil {
+				return err
+			}
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func writeComparisonMarkdown(w io.Writer, comparison Comparison) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Environment: %s\n\n", comparison.Environment)
+	fmt.Fprintf(&b, "%d runs per algorithm after %d warm-up, every algorithm sorts the same dataset\n", comparison.Runs, comparison.WarmupRuns)
+
+	for _, comparisonCase := range comparison.Cases {
+		fmt.Fprintf(&b, "\n### %s numbers, %s\n\n", pkg.FormatNumber(comparisonCase.Count), comparisonCase.Distribution.Name())
+		b.WriteString("| Rank | Algorithm | Median | ± σ | P95 | vs fastest | Correct |\n")
+		b.WriteString("|-----:|-----------|-------:|----:|----:|-----------:|:-------:|\n")
+
+		for _, entry := range comparisonCase.Entries {
+			fmt.Fprintf(&b, "| %d | %s | %v | %v | %v | %.2fx | %t |\n",
+				entry.Rank,
+				entry.Name,
+				entry.Stats.Median,
+				entry.Stats.StdDev,
+				entry.Stats.P95,
+				entry.Relative,
+				entry.Matches)
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
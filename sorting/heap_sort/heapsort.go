@@ -0,0 +1,176 @@
+package heap_sort
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/JoaoVitor615/algorithms-in-go/pkg"
+)
+
+// HeapSort sorts an array using the Heap Sort algorithm
+// Time Complexity: O(n log n) in the best, average and worst case
+// Space Complexity: O(1)
+func HeapSort(arr []int) []int {
+	return HeapSortOrdered(arr)
+}
+
+// HeapSortInstrumented sorts an array like HeapSort and records the comparisons,
+// swaps and allocations it performs in counter
+func HeapSortInstrumented(arr []int, counter *pkg.OperationCounter) []int {
+	if len(arr) <= 1 {
+		return slices.Clone(arr)
+	}
+
+	result := make([]int, len(arr))
+	copy(result, arr)
+	counter.Allocate(len(result))
+
+	heapSort(result, pkg.CountComparisons(counter, cmp.Compare[int]), nil, counter, nil)
+	return result
+}
+
+// HeapSortTraced sorts an array like HeapSort and records every comparison and swap
+// it performs, with the indices involved, in tracer
+func HeapSortTraced(arr []int, tracer *pkg.Tracer) []int {
+	result := slices.Clone(arr)
+	heapSort(result, cmp.Compare[int], nil, nil, tracer)
+	return result
+}
+
+// HeapSortInPlace sorts an array in-place using the Heap Sort algorithm
+func HeapSortInPlace(arr []int) {
+	HeapSortInPlaceOrdered(arr)
+}
+
+// NewSorter returns a pkg.Sorter that runs HeapSort and records the operations it performs in counter
+// counter may be nil when the caller does not need operation counts
+func NewSorter(counter *pkg.OperationCounter) pkg.Sorter {
+	return pkg.SorterFunc(func(arr []int) {
+		if len(arr) <= 1 {
+			return
+		}
+		heapSort(arr, pkg.CountComparisons(counter, cmp.Compare[int]), nil, counter, nil)
+	})
+}
+
+// HeapSortWithCallback sorts an array and calls a callback function after each swap
+// This is useful for visualization or educational purposes
+func HeapSortWithCallback(arr []int, callback func([]int, int, int)) []int {
+	return HeapSortWithCallbackOrdered(arr, callback)
+}
+
+// HeapSortDescending sorts an array in descending order using Heap Sort
+func HeapSortDescending(arr []int) []int {
+	return HeapSortDescendingOrdered(arr)
+}
+
+// HeapSortOrdered sorts a slice of any ordered type (integers, floats, strings)
+// It returns a sorted copy and leaves the original slice untouched
+func HeapSortOrdered[T cmp.Ordered](arr []T) []T {
+	return HeapSortFunc(arr, cmp.Compare[T])
+}
+
+// HeapSortFunc sorts a slice of any type using a comparator function
+// The comparator must return a negative number when a < b, zero when a == b
+// and a positive number when a > b, matching the contract of cmp.Compare
+func HeapSortFunc[T any](arr []T, compare func(a, b T) int) []T {
+	return HeapSortWithCallbackFunc(arr, compare, nil)
+}
+
+// HeapSortInPlaceOrdered sorts a slice of any ordered type in-place
+func HeapSortInPlaceOrdered[T cmp.Ordered](arr []T) {
+	HeapSortInPlaceFunc(arr, cmp.Compare[T])
+}
+
+// HeapSortInPlaceFunc sorts a slice in-place using a comparator function
+func HeapSortInPlaceFunc[T any](arr []T, compare func(a, b T) int) {
+	heapSort(arr, compare, nil, nil, nil)
+}
+
+// HeapSortWithCallbackOrdered sorts a slice of any ordered type and calls a callback after each swap
+func HeapSortWithCallbackOrdered[T cmp.Ordered](arr []T, callback func([]T, int, int)) []T {
+	return HeapSortWithCallbackFunc(arr, cmp.Compare[T], callback)
+}
+
+// HeapSortWithCallbackFunc sorts a slice using a comparator function and calls a callback after each swap
+func HeapSortWithCallbackFunc[T any](arr []T, compare func(a, b T) int, callback func([]T, int, int)) []T {
+	if len(arr) <= 1 {
+		return slices.Clone(arr)
+	}
+
+	// Make a copy to avoid modifying the original array
+	result := make([]T, len(arr))
+	copy(result, arr)
+
+	heapSort(result, compare, callback, nil, nil)
+	return result
+}
+
+// HeapSortDescendingOrdered sorts a slice of any ordered type in descending order
+// Callers of the comparator versions get descending order by swapping the arguments of their comparator
+func HeapSortDescendingOrdered[T cmp.Ordered](arr []T) []T {
+	return HeapSortFunc(arr, func(a, b T) int {
+		return cmp.Compare(b, a)
+	})
+}
+
+// heapSort builds a max-heap and repeatedly moves the largest element to the end
+// callback, counter and tracer may be nil when the caller does not need them
+func heapSort[T any](arr []T, compare func(a, b T) int, callback func([]T, int, int), counter *pkg.OperationCounter, tracer *pkg.Tracer) {
+	n := len(arr)
+	if n <= 1 {
+		return
+	}
+
+	// Build a max-heap, starting from the last parent node
+	for i := n/2 - 1; i >= 0; i-- {
+		siftDown(arr, i, n, compare, callback, counter, tracer)
+	}
+
+	// Move the current maximum to the end and restore the heap on the rest
+	for end := n - 1; end > 0; end-- {
+		arr[0], arr[end] = arr[end], arr[0]
+		counter.Swap()
+		tracer.Swap(0, end)
+		if callback != nil {
+			callback(arr, 0, end)
+		}
+		siftDown(arr, 0, end, compare, callback, counter, tracer)
+	}
+}
+
+// siftDown moves the element at root down until both children are smaller or equal
+// Only the first n elements of the array belong to the heap
+func siftDown[T any](arr []T, root, n int, compare func(a, b T) int, callback func([]T, int, int), counter *pkg.OperationCounter, tracer *pkg.Tracer) {
+	for {
+		largest := root
+		left := 2*root + 1
+		right := left + 1
+
+		if left < n {
+			tracer.Compare(left, largest)
+			if compare(arr[left], arr[largest]) > 0 {
+				largest = left
+			}
+		}
+		if right < n {
+			tracer.Compare(right, largest)
+			if compare(arr[right], arr[largest]) > 0 {
+				largest = right
+			}
+		}
+
+		// The heap property holds for this subtree
+		if largest == root {
+			return
+		}
+
+		arr[root], arr[largest] = arr[largest], arr[root]
+		counter.Swap()
+		tracer.Swap(root, largest)
+		if callback != nil {
+			callback(arr, root, largest)
+		}
+		root = largest
+	}
+}
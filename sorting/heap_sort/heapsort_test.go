@@ -0,0 +1,375 @@
+package heap_sort
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/JoaoVitor615/algorithms-in-go/pkg"
+)
+
+// heapSortTestCases is the shared table used by the int and generic HeapSort tests.
+var heapSortTestCases = []struct {
+	name     string
+	input    []int
+	expected []int
+}{
+	{
+		name:     "Empty array",
+		input:    []int{},
+		expected: []int{},
+	},
+	{
+		name:     "Single element",
+		input:    []int{5},
+		expected: []int{5},
+	},
+	{
+		name:     "Two elements",
+		input:    []int{2, 1},
+		expected: []int{1, 2},
+	},
+	{
+		name:     "Already sorted array",
+		input:    []int{1, 2, 3, 4, 5},
+		expected: []int{1, 2, 3, 4, 5},
+	},
+	{
+		name:     "Reverse sorted array",
+		input:    []int{5, 4, 3, 2, 1},
+		expected: []int{1, 2, 3, 4, 5},
+	},
+	{
+		name:     "Unsorted array with even number of elements",
+		input:    []int{4, 2, 5, 1, 3, 6},
+		expected: []int{1, 2, 3, 4, 5, 6},
+	},
+	{
+		name:     "Unsorted array with odd number of elements",
+		input:    []int{4, 2, 5, 1, 3},
+		expected: []int{1, 2, 3, 4, 5},
+	},
+	{
+		name:     "Array with duplicate elements",
+		input:    []int{4, 2, 5, 1, 3, 2, 4},
+		expected: []int{1, 2, 2, 3, 4, 4, 5},
+	},
+	{
+		name:     "Array with all same elements",
+		input:    []int{3, 3, 3, 3, 3},
+		expected: []int{3, 3, 3, 3, 3},
+	},
+	{
+		name:     "Array with negative numbers",
+		input:    []int{-5, 2, -3, 8, 1, -1},
+		expected: []int{-5, -3, -1, 1, 2, 8},
+	},
+	{
+		name:     "Large random array",
+		input:    []int{64, 34, 25, 12, 22, 11, 90, 88, 76, 50, 42},
+		expected: []int{11, 12, 22, 25, 34, 42, 50, 64, 76, 88, 90},
+	},
+}
+
+// TestHeapSort runs unit tests for the HeapSort function.
+func TestHeapSort(t *testing.T) {
+	// Iterate through each test case.
+	for _, tc := range heapSortTestCases {
+		// Run the test in a subtest for clear output.
+		t.Run(tc.name, func(t *testing.T) {
+			// Make a copy of input to ensure original isn't modified
+			originalInput := make([]int, len(tc.input))
+			copy(originalInput, tc.input)
+
+			// Call the HeapSort function
+			result := HeapSort(tc.input)
+
+			// Verify original input wasn't modified
+			if !reflect.DeepEqual(tc.input, originalInput) {
+				t.Errorf("HeapSort modified the original input array")
+			}
+
+			// Compare the result with the expected output
+			if !reflect.DeepEqual(result, tc.expected) {
+				t.Errorf("HeapSort(%v) = %v; want %v", tc.input, result, tc.expected)
+			}
+		})
+	}
+}
+
+// TestHeapSortInPlace tests the in-place version of HeapSort
+func TestHeapSortInPlace(t *testing.T) {
+	for _, tc := range heapSortTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Make a copy for in-place sorting
+			input := make([]int, len(tc.input))
+			copy(input, tc.input)
+
+			// Call the in-place HeapSort function
+			HeapSortInPlace(input)
+
+			// Compare the result with the expected output
+			if !reflect.DeepEqual(input, tc.expected) {
+				t.Errorf("HeapSortInPlace modified array to %v; want %v", input, tc.expected)
+			}
+		})
+	}
+}
+
+// TestHeapSortWithCallback tests HeapSort with callback functionality
+func TestHeapSortWithCallback(t *testing.T) {
+	input := []int{4, 2, 5, 1, 3}
+	expected := []int{1, 2, 3, 4, 5}
+
+	swapCount := 0
+	callback := func(arr []int, i, j int) {
+		swapCount++
+		// Verify that indices are valid
+		if i < 0 || i >= len(arr) || j < 0 || j >= len(arr) {
+			t.Errorf("Invalid indices in callback: i=%d, j=%d, len=%d", i, j, len(arr))
+		}
+	}
+
+	result := HeapSortWithCallback(input, callback)
+
+	// Verify the result is correct
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("HeapSortWithCallback(%v) = %v; want %v", input, result, expected)
+	}
+
+	// Verify that callback was called (swaps occurred)
+	if swapCount == 0 {
+		t.Error("Expected callback to be called for swaps, but swapCount is 0")
+	}
+
+	// Test with nil callback (should not panic)
+	result2 := HeapSortWithCallback(input, nil)
+	if !reflect.DeepEqual(result2, expected) {
+		t.Errorf("HeapSortWithCallback with nil callback failed")
+	}
+}
+
+// TestHeapSortDescending tests the descending order version
+func TestHeapSortDescending(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    []int
+		expected []int
+	}{
+		{
+			name:     "Empty array",
+			input:    []int{},
+			expected: []int{},
+		},
+		{
+			name:     "Single element",
+			input:    []int{5},
+			expected: []int{5},
+		},
+		{
+			name:     "Unsorted array",
+			input:    []int{4, 2, 5, 1, 3},
+			expected: []int{5, 4, 3, 2, 1},
+		},
+		{
+			name:     "Array with duplicates",
+			input:    []int{4, 2, 5, 1, 3, 2, 4},
+			expected: []int{5, 4, 4, 3, 2, 2, 1},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := HeapSortDescending(tc.input)
+			if !reflect.DeepEqual(result, tc.expected) {
+				t.Errorf("HeapSortDescending(%v) = %v; want %v", tc.input, result, tc.expected)
+			}
+		})
+	}
+}
+
+// TestHeapSortFunc re-runs the HeapSort table against the comparator version
+func TestHeapSortFunc(t *testing.T) {
+	for _, tc := range heapSortTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			originalInput := make([]int, len(tc.input))
+			copy(originalInput, tc.input)
+
+			result := HeapSortFunc(tc.input, cmp.Compare[int])
+
+			if !reflect.DeepEqual(tc.input, originalInput) {
+				t.Errorf("HeapSortFunc modified the original input array")
+			}
+
+			if !reflect.DeepEqual(result, tc.expected) {
+				t.Errorf("HeapSortFunc(%v) = %v; want %v", tc.input, result, tc.expected)
+			}
+		})
+	}
+}
+
+// TestHeapSortGenericTypes tests the generic versions with non-int element types
+func TestHeapSortGenericTypes(t *testing.T) {
+	t.Run("Strings", func(t *testing.T) {
+		input := []string{"pear", "apple", "fig", "banana"}
+		expected := []string{"apple", "banana", "fig", "pear"}
+
+		result := HeapSortOrdered(input)
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("HeapSortOrdered(%v) = %v; want %v", input, result, expected)
+		}
+	})
+
+	t.Run("Floats", func(t *testing.T) {
+		input := []float64{3.5, -1.25, 2, 0, 2}
+		expected := []float64{-1.25, 0, 2, 2, 3.5}
+
+		HeapSortInPlaceOrdered(input)
+		if !reflect.DeepEqual(input, expected) {
+			t.Errorf("HeapSortInPlaceOrdered = %v; want %v", input, expected)
+		}
+	})
+
+	t.Run("Structs by field", func(t *testing.T) {
+		type person struct {
+			name string
+			age  int
+		}
+		input := []person{{"Ana", 31}, {"Bruno", 25}, {"Carla", 40}}
+		expected := []person{{"Bruno", 25}, {"Ana", 31}, {"Carla", 40}}
+
+		HeapSortInPlaceFunc(input, func(a, b person) int {
+			return cmp.Compare(a.age, b.age)
+		})
+		if !reflect.DeepEqual(input, expected) {
+			t.Errorf("HeapSortInPlaceFunc = %v; want %v", input, expected)
+		}
+	})
+}
+
+// TestHeapSortInstrumented tests the operation counts of the instrumented version
+func TestHeapSortInstrumented(t *testing.T) {
+	input := []int{64, 34, 25, 12, 22, 11, 90, 88, 76, 50, 42}
+	counter := pkg.NewOperationCounter()
+
+	result := HeapSortInstrumented(input, counter)
+	if !reflect.DeepEqual(result, HeapSort(input)) {
+		t.Errorf("HeapSortInstrumented(%v) = %v; want sorted output", input, result)
+	}
+
+	// Every extraction swaps the root with the end of the heap
+	if counter.Swaps < int64(len(input)-1) {
+		t.Errorf("counted %d swaps; want at least %d", counter.Swaps, len(input)-1)
+	}
+	if counter.Comparisons == 0 || counter.MaxDepth != 0 || counter.Allocations != 1 {
+		t.Errorf("unexpected counts: %+v", *counter)
+	}
+}
+
+// TestHeapSortTraced tests that the trace replays to the sorted result and agrees with the operation counts
+func TestHeapSortTraced(t *testing.T) {
+	for _, tc := range heapSortTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			counter := pkg.NewOperationCounter()
+			expected := HeapSortInstrumented(tc.input, counter)
+
+			if result := HeapSortTraced(tc.input, nil); !reflect.DeepEqual(result, expected) {
+				t.Errorf("HeapSortTraced(%v, nil) = %v; want %v", tc.input, result, expected)
+			}
+
+			summary, err := pkg.SummarizeTrace(pkg.RecordTrace("heap", HeapSortTraced, tc.input))
+			if err != nil {
+				t.Fatalf("SummarizeTrace() error = %v", err)
+			}
+			if !reflect.DeepEqual(summary.Result, expected) || !summary.Sorted {
+				t.Errorf("replayed trace ends with %v; want %v", summary.Result, expected)
+			}
+			if summary.Counts[pkg.TraceCompare] != int(counter.Comparisons) || summary.Counts[pkg.TraceSwap] != int(counter.Swaps) {
+				t.Errorf("traced %d comparisons and %d swaps; counted %d and %d",
+					summary.Counts[pkg.TraceCompare], summary.Counts[pkg.TraceSwap], counter.Comparisons, counter.Swaps)
+			}
+		})
+	}
+}
+
+// BenchmarkHeapSort benchmarks the HeapSort function
+func BenchmarkHeapSort(b *testing.B) {
+	sizes := []int{100, 1000, 10000}
+
+	for _, size := range sizes {
+		b.Run(fmt.Sprintf("reverse_size_%d", size), func(b *testing.B) {
+			// Generate reverse sorted test data
+			data := make([]int, size)
+			for i := 0; i < size; i++ {
+				data[i] = size - i
+			}
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				HeapSort(data)
+			}
+		})
+
+		b.Run(fmt.Sprintf("sorted_size_%d", size), func(b *testing.B) {
+			// Generate sorted test data (heap sort has no best case shortcut)
+			data := make([]int, size)
+			for i := 0; i < size; i++ {
+				data[i] = i + 1
+			}
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				HeapSort(data)
+			}
+		})
+	}
+}
+
+// BenchmarkHeapSortInPlace benchmarks the in-place HeapSort function
+func BenchmarkHeapSortInPlace(b *testing.B) {
+	sizes := []int{100, 1000, 10000}
+
+	for _, size := range sizes {
+		b.Run(fmt.Sprintf("size_%d", size), func(b *testing.B) {
+			// Generate test data
+			data := make([]int, size)
+			for i := 0; i < size; i++ {
+				data[i] = size - i
+			}
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				// Make a copy for each iteration
+				testData := make([]int, len(data))
+				copy(testData, data)
+				b.StartTimer()
+
+				HeapSortInPlace(testData)
+			}
+		})
+	}
+}
+
+// FuzzHeapSort checks the sort properties of every Heap Sort variant on fuzzed inputs
+func FuzzHeapSort(f *testing.F) {
+	for _, tc := range heapSortTestCases {
+		f.Add(pkg.BytesFromInts(tc.input))
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		input := pkg.IntsFromBytes(data)
+		checks := map[string]error{
+			"HeapSort":        pkg.CheckSort(HeapSort, input),
+			"HeapSortOrdered": pkg.CheckSort(HeapSortOrdered[int], input),
+			"HeapSortInPlace": pkg.CheckSortInPlace(HeapSortInPlace, input),
+			"NewSorter":       pkg.CheckSorter(NewSorter(pkg.NewOperationCounter()), input),
+		}
+		for name, err := range checks {
+			if err != nil {
+				t.Errorf("%s: %v", name, err)
+			}
+		}
+	})
+}
@@ -1,30 +1,40 @@
 package insertion_sort
 
+import (
+	"cmp"
+	"slices"
+
+	"github.com/JoaoVitor615/algorithms-in-go/pkg"
+)
+
 // InsertionSort sorts an array using the Insertion Sort algorithm
 // Time Complexity: O(n²) worst and average case, O(n) best case
 // Space Complexity: O(1)
 func InsertionSort(arr []int) []int {
+	return InsertionSortOrdered(arr)
+}
+
+// InsertionSortInstrumented sorts an array like InsertionSort and records the
+// comparisons, element writes and allocations it performs in counter
+func InsertionSortInstrumented(arr []int, counter *pkg.OperationCounter) []int {
 	if len(arr) <= 1 {
-		return arr
+		return slices.Clone(arr)
 	}
 
-	// Make a copy to avoid modifying the original array
 	result := make([]int, len(arr))
 	copy(result, arr)
+	counter.Allocate(len(result))
 
-	// Perform insertion sort
-	for i := 1; i < len(result); i++ {
-		key := result[i]
-		j := i - 1
-
-		// Move elements that are greater than key one position ahead
-		for j >= 0 && result[j] > key {
-			result[j+1] = result[j]
-			j--
-		}
-		result[j+1] = key
-	}
+	insertionSort(result, pkg.CountComparisons(counter, cmp.Compare[int]), counter)
+	return result
+}
 
+// InsertionSortTraced sorts an array like InsertionSort and records every comparison and
+// element write it performs in tracer
+// The key being inserted is compared at the position of the gap it will fill
+func InsertionSortTraced(arr []int, tracer *pkg.Tracer) []int {
+	result := slices.Clone(arr)
+	insertionSortWithGap(result, 1, cmp.Compare[int], nil, tracer)
 	return result
 }
 
@@ -33,74 +43,160 @@ func InsertionSort(arr []int) []int {
 // Time Complexity: O(n²) worst case (due to shifting), O(n log n) comparisons
 // Space Complexity: O(1)
 func InsertionSortOptimized(arr []int) []int {
+	return InsertionSortOptimizedOrdered(arr)
+}
+
+// InsertionSortInPlace sorts an array in-place using the Insertion Sort algorithm
+func InsertionSortInPlace(arr []int) {
+	InsertionSortInPlaceOrdered(arr)
+}
+
+// NewSorter returns a pkg.Sorter that runs InsertionSort and records the operations it performs in counter
+// counter may be nil when the caller does not need operation counts
+func NewSorter(counter *pkg.OperationCounter) pkg.Sorter {
+	return pkg.SorterFunc(func(arr []int) {
+		if len(arr) <= 1 {
+			return
+		}
+		insertionSort(arr, pkg.CountComparisons(counter, cmp.Compare[int]), counter)
+	})
+}
+
+// InsertionSortInPlaceOptimized sorts an array in-place using optimized Insertion Sort
+func InsertionSortInPlaceOptimized(arr []int) {
+	InsertionSortInPlaceOptimizedOrdered(arr)
+}
+
+// InsertionSortWithCallback sorts an array and calls a callback function after each insertion
+// This is useful for visualization or educational purposes
+func InsertionSortWithCallback(arr []int, callback func([]int, int, int)) []int {
+	return InsertionSortWithCallbackOrdered(arr, callback)
+}
+
+// InsertionSortDescending sorts an array in descending order using Insertion Sort
+func InsertionSortDescending(arr []int) []int {
+	return InsertionSortDescendingOrdered(arr)
+}
+
+// InsertionSortWithGap sorts an array using Insertion Sort with a custom gap
+// Shell Sort chains these passes with decreasing gaps, but a single pass can be useful on its own
+func InsertionSortWithGap(arr []int, gap int) []int {
+	return InsertionSortWithGapOrdered(arr, gap)
+}
+
+// InsertionSortOrdered sorts a slice of any ordered type (integers, floats, strings)
+// It returns a sorted copy and leaves the original slice untouched
+func InsertionSortOrdered[T cmp.Ordered](arr []T) []T {
+	return InsertionSortFunc(arr, cmp.Compare[T])
+}
+
+// InsertionSortFunc sorts a slice of any type using a comparator function
+// The comparator must return a negative number when a < b, zero when a == b
+// and a positive number when a > b, matching the contract of cmp.Compare
+func InsertionSortFunc[T any](arr []T, compare func(a, b T) int) []T {
 	if len(arr) <= 1 {
-		return arr
+		return slices.Clone(arr)
 	}
 
 	// Make a copy to avoid modifying the original array
-	result := make([]int, len(arr))
+	result := make([]T, len(arr))
 	copy(result, arr)
 
-	// Perform optimized insertion sort
-	for i := 1; i < len(result); i++ {
-		key := result[i]
+	InsertionSortInPlaceFunc(result, compare)
+	return result
+}
 
-		// Find location to insert using binary search
-		left, right := 0, i
-		for left < right {
-			mid := (left + right) / 2
-			if result[mid] > key {
-				right = mid
-			} else {
-				left = mid + 1
-			}
-		}
+// InsertionSortOptimizedOrdered sorts a slice of any ordered type using binary Insertion Sort
+func InsertionSortOptimizedOrdered[T cmp.Ordered](arr []T) []T {
+	return InsertionSortOptimizedFunc(arr, cmp.Compare[T])
+}
 
-		// Shift elements to make room for insertion
-		for j := i; j > left; j-- {
-			result[j] = result[j-1]
-		}
-		result[left] = key
+// InsertionSortOptimizedFunc sorts a slice using binary Insertion Sort and a comparator function
+func InsertionSortOptimizedFunc[T any](arr []T, compare func(a, b T) int) []T {
+	if len(arr) <= 1 {
+		return slices.Clone(arr)
 	}
 
+	// Make a copy to avoid modifying the original array
+	result := make([]T, len(arr))
+	copy(result, arr)
+
+	InsertionSortInPlaceOptimizedFunc(result, compare)
 	return result
 }
 
-// InsertionSortInPlace sorts an array in-place using the Insertion Sort algorithm
-func InsertionSortInPlace(arr []int) {
-	if len(arr) <= 1 {
+// InsertionSortInPlaceOrdered sorts a slice of any ordered type in-place
+func InsertionSortInPlaceOrdered[T cmp.Ordered](arr []T) {
+	InsertionSortInPlaceFunc(arr, cmp.Compare[T])
+}
+
+// InsertionSortInPlaceFunc sorts a slice in-place using a comparator function
+func InsertionSortInPlaceFunc[T any](arr []T, compare func(a, b T) int) {
+	insertionSort(arr, compare, nil)
+}
+
+// insertionSort performs the linear Insertion Sort in-place
+// counter may be nil when the caller does not need operation counts
+func insertionSort[T any](arr []T, compare func(a, b T) int, counter *pkg.OperationCounter) {
+	insertionSortWithGap(arr, 1, compare, counter, nil)
+}
+
+// insertionSortWithGap sorts every gap-th element of arr in-place, so that each of the
+// gap interleaved subsequences ends up sorted
+// counter and tracer may be nil when the caller does not need operation counts or a trace
+func insertionSortWithGap[T any](arr []T, gap int, compare func(a, b T) int, counter *pkg.OperationCounter, tracer *pkg.Tracer) {
+	if len(arr) <= 1 || gap <= 0 {
 		return
 	}
 
-	// Perform insertion sort in-place
-	for i := 1; i < len(arr); i++ {
+	for i := gap; i < len(arr); i++ {
 		key := arr[i]
-		j := i - 1
+		j := i - gap
 
-		// Move elements that are greater than key one position ahead
-		for j >= 0 && arr[j] > key {
-			arr[j+1] = arr[j]
-			j--
+		// Move elements that are greater than key one gap position ahead
+		for j >= 0 {
+			tracer.Compare(j, j+gap)
+			if compare(arr[j], key) <= 0 {
+				break
+			}
+			arr[j+gap] = arr[j]
+			counter.Write(1)
+			pkg.RecordWrite(tracer, j+gap, arr[j])
+			j -= gap
 		}
-		arr[j+1] = key
+		arr[j+gap] = key
+		counter.Write(1)
+		pkg.RecordWrite(tracer, j+gap, key)
 	}
 }
 
-// InsertionSortInPlaceOptimized sorts an array in-place using optimized Insertion Sort
-func InsertionSortInPlaceOptimized(arr []int) {
+// InsertionSortInPlaceOptimizedOrdered sorts a slice of any ordered type in-place using binary Insertion Sort
+func InsertionSortInPlaceOptimizedOrdered[T cmp.Ordered](arr []T) {
+	InsertionSortInPlaceOptimizedFunc(arr, cmp.Compare[T])
+}
+
+// InsertionSortInPlaceOptimizedFunc sorts a slice in-place using binary Insertion Sort and a comparator function
+func InsertionSortInPlaceOptimizedFunc[T any](arr []T, compare func(a, b T) int) {
+	InsertionSortInPlaceOptimizedFromFunc(arr, 1, compare)
+}
+
+// InsertionSortInPlaceOptimizedFromFunc sorts a slice in-place like InsertionSortInPlaceOptimizedFunc
+// when arr[:start] is already sorted, inserting only the elements from start onwards
+// Timsort uses it to extend a natural run without comparing the run against itself again
+func InsertionSortInPlaceOptimizedFromFunc[T any](arr []T, start int, compare func(a, b T) int) {
 	if len(arr) <= 1 {
 		return
 	}
 
 	// Perform optimized insertion sort in-place
-	for i := 1; i < len(arr); i++ {
+	for i := max(start, 1); i < len(arr); i++ {
 		key := arr[i]
 
 		// Find location to insert using binary search
 		left, right := 0, i
 		for left < right {
 			mid := (left + right) / 2
-			if arr[mid] > key {
+			if compare(arr[mid], key) > 0 {
 				right = mid
 			} else {
 				left = mid + 1
@@ -115,15 +211,19 @@ func InsertionSortInPlaceOptimized(arr []int) {
 	}
 }
 
-// InsertionSortWithCallback sorts an array and calls a callback function after each insertion
-// This is useful for visualization or educational purposes
-func InsertionSortWithCallback(arr []int, callback func([]int, int, int)) []int {
+// InsertionSortWithCallbackOrdered sorts a slice of any ordered type and calls a callback after each insertion
+func InsertionSortWithCallbackOrdered[T cmp.Ordered](arr []T, callback func([]T, int, int)) []T {
+	return InsertionSortWithCallbackFunc(arr, cmp.Compare[T], callback)
+}
+
+// InsertionSortWithCallbackFunc sorts a slice using a comparator function and calls a callback after each insertion
+func InsertionSortWithCallbackFunc[T any](arr []T, compare func(a, b T) int, callback func([]T, int, int)) []T {
 	if len(arr) <= 1 {
-		return arr
+		return slices.Clone(arr)
 	}
 
 	// Make a copy to avoid modifying the original array
-	result := make([]int, len(arr))
+	result := make([]T, len(arr))
 	copy(result, arr)
 
 	// Perform insertion sort with callback
@@ -132,7 +232,7 @@ func InsertionSortWithCallback(arr []int, callback func([]int, int, int)) []int
 		j := i - 1
 
 		// Move elements that are greater than key one position ahead
-		for j >= 0 && result[j] > key {
+		for j >= 0 && compare(result[j], key) > 0 {
 			result[j+1] = result[j]
 			j--
 		}
@@ -147,55 +247,41 @@ func InsertionSortWithCallback(arr []int, callback func([]int, int, int)) []int
 	return result
 }
 
-// InsertionSortDescending sorts an array in descending order using Insertion Sort
-func InsertionSortDescending(arr []int) []int {
-	if len(arr) <= 1 {
-		return arr
-	}
-
-	// Make a copy to avoid modifying the original array
-	result := make([]int, len(arr))
-	copy(result, arr)
-
-	// Perform insertion sort in descending order
-	for i := 1; i < len(result); i++ {
-		key := result[i]
-		j := i - 1
-
-		// Move elements that are smaller than key one position ahead
-		for j >= 0 && result[j] < key {
-			result[j+1] = result[j]
-			j--
-		}
-		result[j+1] = key
-	}
+// InsertionSortDescendingOrdered sorts a slice of any ordered type in descending order
+// Callers of the comparator versions get descending order by swapping the arguments of their comparator
+func InsertionSortDescendingOrdered[T cmp.Ordered](arr []T) []T {
+	return InsertionSortFunc(arr, func(a, b T) int {
+		return cmp.Compare(b, a)
+	})
+}
 
-	return result
+// InsertionSortWithGapOrdered runs a gapped Insertion Sort pass over a slice of any ordered type
+func InsertionSortWithGapOrdered[T cmp.Ordered](arr []T, gap int) []T {
+	return InsertionSortWithGapFunc(arr, gap, cmp.Compare[T])
 }
 
-// InsertionSortWithGap sorts an array using Insertion Sort with a custom gap
-// This is used internally by Shell Sort but can be useful on its own
-func InsertionSortWithGap(arr []int, gap int) []int {
+// InsertionSortWithGapFunc runs a gapped Insertion Sort pass using a comparator function
+func InsertionSortWithGapFunc[T any](arr []T, gap int, compare func(a, b T) int) []T {
 	if len(arr) <= 1 || gap <= 0 {
-		return arr
+		return slices.Clone(arr)
 	}
 
 	// Make a copy to avoid modifying the original array
-	result := make([]int, len(arr))
+	result := make([]T, len(arr))
 	copy(result, arr)
 
-	// Perform insertion sort with gap
-	for i := gap; i < len(result); i++ {
-		key := result[i]
-		j := i - gap
+	insertionSortWithGap(result, gap, compare, nil, nil)
+	return result
+}
 
-		// Move elements that are greater than key one gap position ahead
-		for j >= 0 && result[j] > key {
-			result[j+gap] = result[j]
-			j -= gap
-		}
-		result[j+gap] = key
-	}
+// InsertionSortInPlaceWithGapFunc runs a gapped Insertion Sort pass in-place using a comparator function
+func InsertionSortInPlaceWithGapFunc[T any](arr []T, gap int, compare func(a, b T) int) {
+	insertionSortWithGap(arr, gap, compare, nil, nil)
+}
 
-	return result
+// InsertionSortInPlaceWithGapInstrumented runs a gapped Insertion Sort pass in-place and records
+// the element writes it performs in counter, comparisons are counted by wrapping compare
+// Shell Sort chains these passes with decreasing gaps
+func InsertionSortInPlaceWithGapInstrumented[T any](arr []T, gap int, compare func(a, b T) int, counter *pkg.OperationCounter) {
+	insertionSortWithGap(arr, gap, compare, counter, nil)
 }
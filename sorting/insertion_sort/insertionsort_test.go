@@ -1,73 +1,76 @@
 package insertion_sort
 
 import (
+	"cmp"
 	"fmt"
 	"reflect"
 	"testing"
+
+	"github.com/JoaoVitor615/algorithms-in-go/pkg"
 )
 
+// insertionSortTestCases is the shared table used by the int and generic InsertionSort tests.
+var insertionSortTestCases = []struct {
+	name     string
+	input    []int
+	expected []int
+}{
+	{
+		name:     "Empty array",
+		input:    []int{},
+		expected: []int{},
+	},
+	{
+		name:     "Single element",
+		input:    []int{5},
+		expected: []int{5},
+	},
+	{
+		name:     "Already sorted array",
+		input:    []int{1, 2, 3, 4, 5},
+		expected: []int{1, 2, 3, 4, 5},
+	},
+	{
+		name:     "Reverse sorted array",
+		input:    []int{5, 4, 3, 2, 1},
+		expected: []int{1, 2, 3, 4, 5},
+	},
+	{
+		name:     "Unsorted array with even number of elements",
+		input:    []int{4, 2, 5, 1, 3, 6},
+		expected: []int{1, 2, 3, 4, 5, 6},
+	},
+	{
+		name:     "Unsorted array with odd number of elements",
+		input:    []int{4, 2, 5, 1, 3},
+		expected: []int{1, 2, 3, 4, 5},
+	},
+	{
+		name:     "Array with duplicate elements",
+		input:    []int{4, 2, 5, 1, 3, 2, 4},
+		expected: []int{1, 2, 2, 3, 4, 4, 5},
+	},
+	{
+		name:     "Array with all same elements",
+		input:    []int{3, 3, 3, 3, 3},
+		expected: []int{3, 3, 3, 3, 3},
+	},
+	{
+		name:     "Array with negative numbers",
+		input:    []int{-5, 2, -3, 8, 1, -1},
+		expected: []int{-5, -3, -1, 1, 2, 8},
+	},
+	{
+		name:     "Large random array",
+		input:    []int{64, 34, 25, 12, 22, 11, 90, 88, 76, 50, 42},
+		expected: []int{11, 12, 22, 25, 34, 42, 50, 64, 76, 88, 90},
+	},
+}
+
 // TestInsertionSort runs unit tests for the InsertionSort function.
 func TestInsertionSort(t *testing.T) {
-	// Define a series of test cases.
-	testCases := []struct {
-		name     string
-		input    []int
-		expected []int
-	}{
-		{
-			name:     "Empty array",
-			input:    []int{},
-			expected: []int{},
-		},
-		{
-			name:     "Single element",
-			input:    []int{5},
-			expected: []int{5},
-		},
-		{
-			name:     "Already sorted array",
-			input:    []int{1, 2, 3, 4, 5},
-			expected: []int{1, 2, 3, 4, 5},
-		},
-		{
-			name:     "Reverse sorted array",
-			input:    []int{5, 4, 3, 2, 1},
-			expected: []int{1, 2, 3, 4, 5},
-		},
-		{
-			name:     "Unsorted array with even number of elements",
-			input:    []int{4, 2, 5, 1, 3, 6},
-			expected: []int{1, 2, 3, 4, 5, 6},
-		},
-		{
-			name:     "Unsorted array with odd number of elements",
-			input:    []int{4, 2, 5, 1, 3},
-			expected: []int{1, 2, 3, 4, 5},
-		},
-		{
-			name:     "Array with duplicate elements",
-			input:    []int{4, 2, 5, 1, 3, 2, 4},
-			expected: []int{1, 2, 2, 3, 4, 4, 5},
-		},
-		{
-			name:     "Array with all same elements",
-			input:    []int{3, 3, 3, 3, 3},
-			expected: []int{3, 3, 3, 3, 3},
-		},
-		{
-			name:     "Array with negative numbers",
-			input:    []int{-5, 2, -3, 8, 1, -1},
-			expected: []int{-5, -3, -1, 1, 2, 8},
-		},
-		{
-			name:     "Large random array",
-			input:    []int{64, 34, 25, 12, 22, 11, 90, 88, 76, 50, 42},
-			expected: []int{11, 12, 22, 25, 34, 42, 50, 64, 76, 88, 90},
-		},
-	}
-
 	// Iterate through each test case.
-	for _, tc := range testCases {
+	for _, tc := range insertionSortTestCases {
 		// Run the test in a subtest for clear output.
 		t.Run(tc.name, func(t *testing.T) {
 			// Make a copy of input to ensure original isn't modified
@@ -248,6 +251,37 @@ func TestInsertionSortInPlaceOptimized(t *testing.T) {
 	}
 }
 
+// TestInsertionSortInPlaceOptimizedFrom tests that only the elements after the sorted prefix are inserted
+func TestInsertionSortInPlaceOptimizedFrom(t *testing.T) {
+	testCases := []struct {
+		name        string
+		input       []int
+		start       int
+		expected    []int
+		comparisons int64
+	}{
+		{"Empty array", []int{}, 0, []int{}, 0},
+		{"Start zero sorts the whole array", []int{3, 1, 2}, 0, []int{1, 2, 3}, 3},
+		{"Sorted prefix is not compared again", []int{1, 3, 5, 7, 4, 2}, 4, []int{1, 2, 3, 4, 5, 7}, 5},
+		{"Whole array as prefix", []int{1, 2, 3, 4, 5}, 5, []int{1, 2, 3, 4, 5}, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			counter := pkg.NewOperationCounter()
+			input := append([]int{}, tc.input...)
+			InsertionSortInPlaceOptimizedFromFunc(input, tc.start, pkg.CountComparisons(counter, cmp.Compare[int]))
+
+			if !reflect.DeepEqual(input, tc.expected) {
+				t.Errorf("InsertionSortInPlaceOptimizedFromFunc(%v, %d) sorted to %v; want %v", tc.input, tc.start, input, tc.expected)
+			}
+			if counter.Comparisons != tc.comparisons {
+				t.Errorf("counted %d comparisons; want %d", counter.Comparisons, tc.comparisons)
+			}
+		})
+	}
+}
+
 // TestInsertionSortWithCallback tests InsertionSort with callback functionality
 func TestInsertionSortWithCallback(t *testing.T) {
 	input := []int{4, 2, 5, 1, 3}
@@ -364,22 +398,207 @@ func TestInsertionSortWithGap(t *testing.T) {
 	}
 }
 
-// TestInsertionSortStability tests that InsertionSort is stable
+// TestInsertionSortOrdered re-runs the InsertionSort table against the generic ordered version
+func TestInsertionSortOrdered(t *testing.T) {
+	for _, tc := range insertionSortTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			originalInput := make([]int, len(tc.input))
+			copy(originalInput, tc.input)
+
+			result := InsertionSortOrdered(tc.input)
+
+			if !reflect.DeepEqual(tc.input, originalInput) {
+				t.Errorf("InsertionSortOrdered modified the original input array")
+			}
+
+			if !reflect.DeepEqual(result, tc.expected) {
+				t.Errorf("InsertionSortOrdered(%v) = %v; want %v", tc.input, result, tc.expected)
+			}
+		})
+	}
+}
+
+// TestInsertionSortFunc re-runs the InsertionSort table against the comparator version
+func TestInsertionSortFunc(t *testing.T) {
+	for _, tc := range insertionSortTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			originalInput := make([]int, len(tc.input))
+			copy(originalInput, tc.input)
+
+			result := InsertionSortFunc(tc.input, cmp.Compare[int])
+
+			if !reflect.DeepEqual(tc.input, originalInput) {
+				t.Errorf("InsertionSortFunc modified the original input array")
+			}
+
+			if !reflect.DeepEqual(result, tc.expected) {
+				t.Errorf("InsertionSortFunc(%v) = %v; want %v", tc.input, result, tc.expected)
+			}
+		})
+	}
+}
+
+// TestInsertionSortInPlaceFunc re-runs the InsertionSort table against the in-place comparator version
+func TestInsertionSortInPlaceFunc(t *testing.T) {
+	for _, tc := range insertionSortTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			input := make([]int, len(tc.input))
+			copy(input, tc.input)
+
+			InsertionSortInPlaceFunc(input, cmp.Compare[int])
+
+			if !reflect.DeepEqual(input, tc.expected) {
+				t.Errorf("InsertionSortInPlaceFunc modified array to %v; want %v", input, tc.expected)
+			}
+		})
+	}
+}
+
+// TestInsertionSortGenericTypes tests the generic versions with non-int element types
+func TestInsertionSortGenericTypes(t *testing.T) {
+	t.Run("Strings", func(t *testing.T) {
+		input := []string{"pear", "apple", "fig", "banana"}
+		expected := []string{"apple", "banana", "fig", "pear"}
+
+		result := InsertionSortOptimizedOrdered(input)
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("InsertionSortOptimizedOrdered(%v) = %v; want %v", input, result, expected)
+		}
+	})
+
+	t.Run("Floats descending", func(t *testing.T) {
+		input := []float64{3.5, -1.25, 2, 0, 2}
+		expected := []float64{3.5, 2, 2, 0, -1.25}
+
+		result := InsertionSortDescendingOrdered(input)
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("InsertionSortDescendingOrdered(%v) = %v; want %v", input, result, expected)
+		}
+	})
+
+	t.Run("Structs keep order of equal keys", func(t *testing.T) {
+		type record struct {
+			key   int
+			label string
+		}
+		input := []record{{3, "a"}, {1, "b"}, {3, "c"}, {2, "d"}, {3, "e"}}
+		expected := []record{{1, "b"}, {2, "d"}, {3, "a"}, {3, "c"}, {3, "e"}}
+		byKey := func(a, b record) int {
+			return cmp.Compare(a.key, b.key)
+		}
+
+		if result := InsertionSortFunc(input, byKey); !reflect.DeepEqual(result, expected) {
+			t.Errorf("InsertionSortFunc(%v) = %v; want %v", input, result, expected)
+		}
+		if result := InsertionSortOptimizedFunc(input, byKey); !reflect.DeepEqual(result, expected) {
+			t.Errorf("InsertionSortOptimizedFunc(%v) = %v; want %v", input, result, expected)
+		}
+	})
+
+	t.Run("Gap with strings", func(t *testing.T) {
+		input := []string{"e", "b", "d", "f", "a", "c"}
+		expected := []string{"a", "b", "d", "c", "e", "f"}
+
+		result := InsertionSortWithGapOrdered(input, 2)
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("InsertionSortWithGapOrdered(%v, 2) = %v; want %v", input, result, expected)
+		}
+	})
+}
+
+// TestInsertionSortInstrumented tests the operation counts of the instrumented version
+func TestInsertionSortInstrumented(t *testing.T) {
+	testCases := []struct {
+		name        string
+		input       []int
+		comparisons int64
+		writes      int64
+	}{
+		{
+			name:        "Already sorted array only places each key",
+			input:       []int{1, 2, 3, 4, 5},
+			comparisons: 4,
+			writes:      4,
+		},
+		{
+			name:        "Reverse sorted array shifts every element",
+			input:       []int{5, 4, 3, 2, 1},
+			comparisons: 10,
+			writes:      14,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			counter := pkg.NewOperationCounter()
+			result := InsertionSortInstrumented(tc.input, counter)
+
+			if !reflect.DeepEqual(result, InsertionSort(tc.input)) {
+				t.Errorf("InsertionSortInstrumented(%v) = %v; want sorted output", tc.input, result)
+			}
+			if counter.Comparisons != tc.comparisons || counter.Writes != tc.writes {
+				t.Errorf("counted %d comparisons and %d writes; want %d and %d",
+					counter.Comparisons, counter.Writes, tc.comparisons, tc.writes)
+			}
+			if counter.Swaps != 0 || counter.Allocations != 1 {
+				t.Errorf("counted %d swaps and %d allocations; want 0 and 1", counter.Swaps, counter.Allocations)
+			}
+		})
+	}
+}
+
+// TestInsertionSortTraced tests that the trace replays to the sorted result and agrees with the operation counts
+func TestInsertionSortTraced(t *testing.T) {
+	for _, tc := range insertionSortTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			counter := pkg.NewOperationCounter()
+			expected := InsertionSortInstrumented(tc.input, counter)
+
+			if result := InsertionSortTraced(tc.input, nil); !reflect.DeepEqual(result, expected) {
+				t.Errorf("InsertionSortTraced(%v, nil) = %v; want %v", tc.input, result, expected)
+			}
+
+			summary, err := pkg.SummarizeTrace(pkg.RecordTrace("insertion", InsertionSortTraced, tc.input))
+			if err != nil {
+				t.Fatalf("SummarizeTrace() error = %v", err)
+			}
+			if !reflect.DeepEqual(summary.Result, expected) || !summary.Sorted {
+				t.Errorf("replayed trace ends with %v; want %v", summary.Result, expected)
+			}
+			if summary.Counts[pkg.TraceCompare] != int(counter.Comparisons) || summary.Counts[pkg.TraceWrite] != int(counter.Writes) {
+				t.Errorf("traced %d comparisons and %d writes; counted %d and %d",
+					summary.Counts[pkg.TraceCompare], summary.Counts[pkg.TraceWrite], counter.Comparisons, counter.Writes)
+			}
+		})
+	}
+}
+
+// TestInsertionSortStability tests that every comparator variant keeps records with equal keys
+// in input order, using the tagged records of the pkg stability harness
 func TestInsertionSortStability(t *testing.T) {
-	// For testing stability, we need a way to distinguish between equal elements
-	// Since we're working with integers, we'll test the behavior with duplicates
-	input := []int{3, 1, 3, 2, 3}
-	expected := []int{1, 2, 3, 3, 3}
+	cases := pkg.StabilityCases(pkg.NewRandomGeneratorWithSeed(1), 200)
 
-	result := InsertionSort(input)
-	if !reflect.DeepEqual(result, expected) {
-		t.Errorf("InsertionSort stability test failed: got %v, want %v", result, expected)
+	sorts := map[string]func([]pkg.Record) []pkg.Record{
+		"InsertionSortFunc": func(records []pkg.Record) []pkg.Record {
+			return InsertionSortFunc(records, pkg.CompareRecords)
+		},
+		"InsertionSortOptimizedFunc": func(records []pkg.Record) []pkg.Record {
+			return InsertionSortOptimizedFunc(records, pkg.CompareRecords)
+		},
+		"InsertionSortInPlaceFunc": func(records []pkg.Record) []pkg.Record {
+			InsertionSortInPlaceFunc(records, pkg.CompareRecords)
+			return records
+		},
+		"InsertionSortInPlaceOptimizedFunc": func(records []pkg.Record) []pkg.Record {
+			InsertionSortInPlaceOptimizedFunc(records, pkg.CompareRecords)
+			return records
+		},
 	}
 
-	// Test with optimized version
-	result2 := InsertionSortOptimized(input)
-	if !reflect.DeepEqual(result2, expected) {
-		t.Errorf("InsertionSortOptimized stability test failed: got %v, want %v", result2, expected)
+	for name, sort := range sorts {
+		if report := pkg.VerifyStability(sort, cases); !report.Stable() {
+			t.Errorf("%s: %s", name, report)
+		}
 	}
 }
 
@@ -507,3 +726,46 @@ func BenchmarkInsertionSortBestCase(b *testing.B) {
 		})
 	}
 }
+
+// TestInsertionSortReturnsCopy tests that the copying variants never hand back the caller's slice,
+// even for inputs short enough to need no sorting
+func TestInsertionSortReturnsCopy(t *testing.T) {
+	sorts := map[string]func([]int) []int{
+		"InsertionSort":           InsertionSort,
+		"InsertionSortOptimized":  InsertionSortOptimized,
+		"InsertionSortDescending": InsertionSortDescending,
+	}
+
+	for name, sort := range sorts {
+		for _, input := range [][]int{{5}, {2, 1}} {
+			sorted := sort(input)
+			sorted[0] = -1
+			if input[0] == -1 {
+				t.Errorf("%s(%d elements) returned a slice sharing memory with its input", name, len(input))
+			}
+		}
+	}
+}
+
+// FuzzInsertionSort checks the sort properties of every Insertion Sort variant on fuzzed inputs
+func FuzzInsertionSort(f *testing.F) {
+	for _, tc := range insertionSortTestCases {
+		f.Add(pkg.BytesFromInts(tc.input))
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		input := pkg.IntsFromBytes(data)
+		checks := map[string]error{
+			"InsertionSort":                 pkg.CheckSort(InsertionSort, input),
+			"InsertionSortOptimized":        pkg.CheckSort(InsertionSortOptimized, input),
+			"InsertionSortInPlace":          pkg.CheckSortInPlace(InsertionSortInPlace, input),
+			"InsertionSortInPlaceOptimized": pkg.CheckSortInPlace(InsertionSortInPlaceOptimized, input),
+			"NewSorter":                     pkg.CheckSorter(NewSorter(pkg.NewOperationCounter()), input),
+		}
+		for name, err := range checks {
+			if err != nil {
+				t.Errorf("%s: %v", name, err)
+			}
+		}
+	})
+}
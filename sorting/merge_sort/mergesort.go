@@ -1,57 +1,186 @@
 package merge_sort
 
-// Node represents a node in the linked list.
-type Node struct {
-	Value int
-	Next  *Node
+import (
+	"cmp"
+
+	"github.com/JoaoVitor615/algorithms-in-go/pkg"
+)
+
+// ListNode represents a node in a linked list holding a value of any type.
+type ListNode[T any] struct {
+	Value T
+	Next  *ListNode[T]
 }
 
+// Node represents a node in the linked list.
+type Node = ListNode[int]
+
 // MergeSort sorts a linked list using the Merge Sort algorithm.
+// The nodes of the input list are relinked rather than copied, so afterwards the list must be
+// read from the returned head: head itself may now sit anywhere in the sorted list.
 func MergeSort(head *Node) *Node {
+	return MergeSortOrdered(head)
+}
+
+// MergeSortInstrumented sorts a linked list like MergeSort and records the comparisons,
+// link writes, recursion depth and helper node allocations it performs in counter.
+func MergeSortInstrumented(head *Node, counter *pkg.OperationCounter) *Node {
+	return mergeSort(head, 0, pkg.CountComparisons(counter, cmp.Compare[int]), counter, nil)
+}
+
+// NewSorter returns a pkg.Sorter that runs MergeSort on a linked list built from the input
+// and records the comparisons, link writes and recursion depth it performs in counter.
+// The list is built and read back outside the sort, so those nodes are not counted as allocations.
+// counter may be nil when the caller does not need operation counts.
+func NewSorter(counter *pkg.OperationCounter) pkg.Sorter {
+	return pkg.SorterFunc(func(arr []int) {
+		copyList(arr, mergeSort(NewList(arr), 0, pkg.CountComparisons(counter, cmp.Compare[int]), counter, nil))
+	})
+}
+
+// MergeSortOrdered sorts a linked list of any ordered type using the Merge Sort algorithm.
+func MergeSortOrdered[T cmp.Ordered](head *ListNode[T]) *ListNode[T] {
+	return MergeSortFunc(head, cmp.Compare[T])
+}
+
+// MergeSortFunc sorts a linked list using the Merge Sort algorithm and a comparator.
+// The comparator must return a negative number when a < b, zero when a == b
+// and a positive number when a > b, matching the contract of cmp.Compare.
+// Equal elements keep their original relative order.
+func MergeSortFunc[T any](head *ListNode[T], compare func(a, b T) int) *ListNode[T] {
+	return mergeSort(head, 0, compare, nil, nil)
+}
+
+// MergeSortWithCallback sorts a linked list like MergeSort and calls a callback after each merge
+// with the values of the whole list in their current order and the first and last position of
+// the merged range, which is useful for visualization.
+func MergeSortWithCallback(head *Node, callback func([]int, int, int)) *Node {
+	return MergeSortWithCallbackOrdered(head, callback)
+}
+
+// MergeSortWithCallbackOrdered sorts a linked list of any ordered type and calls a callback after each merge.
+func MergeSortWithCallbackOrdered[T cmp.Ordered](head *ListNode[T], callback func([]T, int, int)) *ListNode[T] {
+	return MergeSortWithCallbackFunc(head, cmp.Compare[T], callback)
+}
+
+// MergeSortWithCallbackFunc sorts a linked list using a comparator and calls a callback after each merge.
+// The halves being sorted are separate lists until they are merged, so the callback receives a
+// slice holding them back to back; it is reused between calls and must not be kept.
+func MergeSortWithCallbackFunc[T any](head *ListNode[T], compare func(a, b T) int, callback func([]T, int, int)) *ListNode[T] {
+	var steps *mergeSteps[T]
+	if callback != nil {
+		steps = &mergeSteps[T]{values: ListValues(head), callback: callback}
+	}
+	return mergeSort(head, 0, compare, nil, steps)
+}
+
+// mergeSteps reports the merges of a list sort to callback, keeping values in the order of the sublists
+type mergeSteps[T any] struct {
+	values   []T
+	callback func([]T, int, int)
+}
+
+// report copies the merged list, which starts at position low, into values and calls the callback
+func (s *mergeSteps[T]) report(head *ListNode[T], low int) {
+	high := low
+	for ; head != nil; head = head.Next {
+		s.values[high] = head.Value
+		high++
+	}
+	s.callback(s.values, low, high-1)
+}
+
+// mergeSort performs the recursive Merge Sort on the list, whose head sits at position low of the whole list.
+// counter and steps may be nil when the caller does not need operation counts or merge steps.
+func mergeSort[T any](head *ListNode[T], low int, compare func(a, b T) int, counter *pkg.OperationCounter, steps *mergeSteps[T]) *ListNode[T] {
+	counter.Enter()
+	defer counter.Exit()
+
 	if head == nil || head.Next == nil {
 		return head
 	}
 
 	// Find the middle of the list to split it.
 	slow, fast := head, head
-	var prev *Node
+	var prev *ListNode[T]
+	half := 0
 	for fast != nil && fast.Next != nil {
 		prev = slow
 		slow = slow.Next
 		fast = fast.Next.Next
+		half++
 	}
 	prev.Next = nil
+	counter.Write(1)
 
 	// Recursively call Merge Sort on the two halves.
-	left := MergeSort(head)
-	right := MergeSort(slow)
+	left := mergeSort(head, low, compare, counter, steps)
+	right := mergeSort(slow, low+half, compare, counter, steps)
 
 	// Merge the two sorted halves.
-	return merge(left, right)
+	merged := merge(left, right, compare, counter)
+	if steps != nil {
+		steps.report(merged, low)
+	}
+	return merged
 }
 
 // merge combines two sorted linked lists into a single sorted list.
-func merge(l1, l2 *Node) *Node {
-	dummy := &Node{}
+// Ties are taken from l1 first so that the sort stays stable.
+// Every relinked Next pointer is recorded as one write.
+func merge[T any](l1, l2 *ListNode[T], compare func(a, b T) int, counter *pkg.OperationCounter) *ListNode[T] {
+	dummy := &ListNode[T]{}
+	counter.Allocate(1)
 	current := dummy
 
 	for l1 != nil && l2 != nil {
-		if l1.Value < l2.Value {
+		if compare(l1.Value, l2.Value) <= 0 {
 			current.Next = l1
 			l1 = l1.Next
 		} else {
 			current.Next = l2
 			l2 = l2.Next
 		}
+		counter.Write(1)
 		current = current.Next
 	}
 
 	if l1 != nil {
 		current.Next = l1
+		counter.Write(1)
 	}
 	if l2 != nil {
 		current.Next = l2
+		counter.Write(1)
 	}
 
 	return dummy.Next
 }
+
+// NewList builds a linked list holding values in order, nil for an empty slice.
+func NewList[T any](values []T) *ListNode[T] {
+	var dummy ListNode[T]
+	tail := &dummy
+	for _, value := range values {
+		tail.Next = &ListNode[T]{Value: value}
+		tail = tail.Next
+	}
+	return dummy.Next
+}
+
+// ListValues returns the values of the list starting at head, in list order.
+func ListValues[T any](head *ListNode[T]) []T {
+	values := []T{}
+	for ; head != nil; head = head.Next {
+		values = append(values, head.Value)
+	}
+	return values
+}
+
+// copyList writes the values of the list starting at head into arr, in list order.
+func copyList[T any](arr []T, head *ListNode[T]) {
+	for i := 0; head != nil; i++ {
+		arr[i] = head.Value
+		head = head.Next
+	}
+}
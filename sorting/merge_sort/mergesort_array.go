@@ -0,0 +1,314 @@
+package merge_sort
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/JoaoVitor615/algorithms-in-go/pkg"
+)
+
+// MergeSortArray sorts an array using top-down Merge Sort and returns a new sorted array.
+// Every merge allocates its own output, so the input is never modified.
+func MergeSortArray(arr []int) []int {
+	return MergeSortArrayOrdered(arr)
+}
+
+// MergeSortArrayInstrumented sorts an array like MergeSortArray and records the comparisons,
+// element writes, recursion depth and allocations it performs in counter.
+func MergeSortArrayInstrumented(arr []int, counter *pkg.OperationCounter) []int {
+	if len(arr) <= 1 {
+		return slices.Clone(arr)
+	}
+	return mergeSortCopying(arr, pkg.CountComparisons(counter, cmp.Compare[int]), counter)
+}
+
+// MergeSortArrayOrdered sorts a slice of any ordered type using top-down Merge Sort.
+func MergeSortArrayOrdered[T cmp.Ordered](arr []T) []T {
+	return MergeSortArrayFunc(arr, cmp.Compare[T])
+}
+
+// MergeSortArrayFunc sorts a slice using top-down Merge Sort and a comparator.
+// Equal elements keep their original relative order.
+func MergeSortArrayFunc[T any](arr []T, compare func(a, b T) int) []T {
+	if len(arr) <= 1 {
+		return slices.Clone(arr)
+	}
+	return mergeSortCopying(arr, compare, nil)
+}
+
+// MergeSortArrayInPlace sorts an array with top-down Merge Sort, reusing a single
+// auxiliary buffer allocated once instead of a new slice per merge.
+func MergeSortArrayInPlace(arr []int) {
+	MergeSortArrayInPlaceFunc(arr, cmp.Compare[int])
+}
+
+// MergeSortArrayWithCallback sorts an array like MergeSortArrayBuffered and calls a callback after each merge
+// with the first and last index of the merged range, which is useful for visualization.
+func MergeSortArrayWithCallback(arr []int, callback func([]int, int, int)) []int {
+	return MergeSortArrayWithCallbackOrdered(arr, callback)
+}
+
+// MergeSortArrayBuffered sorts a copy of an array like MergeSortArrayInPlace and returns it.
+// It allocates the copy and one buffer instead of a new slice per merge.
+func MergeSortArrayBuffered(arr []int) []int {
+	return MergeSortArrayBufferedInstrumented(arr, nil)
+}
+
+// MergeSortArrayBufferedInstrumented sorts an array like MergeSortArrayBuffered and records the comparisons,
+// element writes, recursion depth and allocations it performs in counter.
+func MergeSortArrayBufferedInstrumented(arr []int, counter *pkg.OperationCounter) []int {
+	if len(arr) <= 1 {
+		return slices.Clone(arr)
+	}
+
+	result := make([]int, len(arr))
+	copy(result, arr)
+	buf := make([]int, len(arr)/2)
+	counter.Allocate(len(result))
+	counter.Allocate(len(buf))
+
+	mergeSortBuffered(result, buf, pkg.CountComparisons(counter, cmp.Compare[int]), counter)
+	return result
+}
+
+// MergeSortArrayTraced sorts an array like MergeSortArrayBuffered and records in tracer every
+// recursive call, the bounds of every merge and each comparison and write the merges perform.
+// Ranges are reported with inclusive bounds, like the callback of MergeSortArrayWithCallback.
+func MergeSortArrayTraced(arr []int, tracer *pkg.Tracer) []int {
+	result := slices.Clone(arr)
+	if len(result) > 1 {
+		mergeSortRange(result, make([]int, len(result)/2), 0, len(result), cmp.Compare[int], nil, tracer)
+	}
+	return result
+}
+
+// NewArraySorter returns a pkg.Sorter that runs MergeSortArrayBuffered and records the
+// operations it performs in counter.
+// counter may be nil when the caller does not need operation counts.
+func NewArraySorter(counter *pkg.OperationCounter) pkg.Sorter {
+	return pkg.SorterFunc(func(arr []int) {
+		if len(arr) <= 1 {
+			return
+		}
+		buf := make([]int, len(arr)/2)
+		counter.Allocate(len(buf))
+		mergeSortBuffered(arr, buf, pkg.CountComparisons(counter, cmp.Compare[int]), counter)
+	})
+}
+
+// MergeSortArrayInPlaceFunc sorts a slice like MergeSortArrayInPlace using a comparator.
+func MergeSortArrayInPlaceFunc[T any](arr []T, compare func(a, b T) int) {
+	if len(arr) <= 1 {
+		return
+	}
+	mergeSortBuffered(arr, make([]T, len(arr)/2), compare, nil)
+}
+
+// MergeSortArrayWithCallbackOrdered sorts a slice of any ordered type and calls a callback after each merge.
+func MergeSortArrayWithCallbackOrdered[T cmp.Ordered](arr []T, callback func([]T, int, int)) []T {
+	return MergeSortArrayWithCallbackFunc(arr, cmp.Compare[T], callback)
+}
+
+// MergeSortArrayWithCallbackFunc sorts a slice using a comparator and calls a callback after each merge.
+// The callback always receives the whole slice, so the merged range can be shown in context.
+func MergeSortArrayWithCallbackFunc[T any](arr []T, compare func(a, b T) int, callback func([]T, int, int)) []T {
+	if len(arr) <= 1 {
+		return slices.Clone(arr)
+	}
+
+	result := make([]T, len(arr))
+	copy(result, arr)
+
+	mergeSortRange(result, make([]T, len(arr)/2), 0, len(result), compare, callback, nil)
+	return result
+}
+
+// MergeSortArrayBottomUp sorts an array using iterative bottom-up Merge Sort and returns
+// a new sorted array. Runs of width 1, 2, 4... are merged without any recursion.
+func MergeSortArrayBottomUp(arr []int) []int {
+	return MergeSortArrayBottomUpFunc(arr, cmp.Compare[int])
+}
+
+// MergeSortArrayBottomUpInstrumented sorts an array like MergeSortArrayBottomUp and records
+// the comparisons, element writes and allocations it performs in counter.
+func MergeSortArrayBottomUpInstrumented(arr []int, counter *pkg.OperationCounter) []int {
+	if len(arr) <= 1 {
+		return slices.Clone(arr)
+	}
+
+	result := make([]int, len(arr))
+	copy(result, arr)
+	counter.Allocate(len(result))
+
+	mergeSortBottomUp(result, pkg.CountComparisons(counter, cmp.Compare[int]), counter)
+	return result
+}
+
+// MergeSortArrayBottomUpFunc sorts a slice using bottom-up Merge Sort and a comparator.
+func MergeSortArrayBottomUpFunc[T any](arr []T, compare func(a, b T) int) []T {
+	if len(arr) <= 1 {
+		return slices.Clone(arr)
+	}
+
+	result := make([]T, len(arr))
+	copy(result, arr)
+
+	mergeSortBottomUp(result, compare, nil)
+	return result
+}
+
+// MergeSortArrayBottomUpInPlace sorts an array with bottom-up Merge Sort, reusing a single
+// auxiliary buffer for every pass.
+func MergeSortArrayBottomUpInPlace(arr []int) {
+	MergeSortArrayBottomUpInPlaceFunc(arr, cmp.Compare[int])
+}
+
+// NewArrayBottomUpSorter returns a pkg.Sorter that runs MergeSortArrayBottomUp and records the
+// operations it performs in counter.
+// counter may be nil when the caller does not need operation counts.
+func NewArrayBottomUpSorter(counter *pkg.OperationCounter) pkg.Sorter {
+	return pkg.SorterFunc(func(arr []int) {
+		if len(arr) <= 1 {
+			return
+		}
+		mergeSortBottomUp(arr, pkg.CountComparisons(counter, cmp.Compare[int]), counter)
+	})
+}
+
+// MergeSortArrayBottomUpInPlaceFunc sorts a slice like MergeSortArrayBottomUpInPlace using a comparator.
+func MergeSortArrayBottomUpInPlaceFunc[T any](arr []T, compare func(a, b T) int) {
+	mergeSortBottomUp(arr, compare, nil)
+}
+
+// mergeSortCopying performs the recursive top-down Merge Sort, returning a new slice for each merge.
+// Leaves are only read by the merge above them, so they are returned as capped views of arr instead
+// of copies; callers must copy an input of at most one element themselves.
+// counter may be nil when the caller does not need operation counts.
+func mergeSortCopying[T any](arr []T, compare func(a, b T) int, counter *pkg.OperationCounter) []T {
+	counter.Enter()
+	defer counter.Exit()
+
+	if len(arr) <= 1 {
+		return arr[:len(arr):len(arr)]
+	}
+
+	mid := len(arr) / 2
+	left := mergeSortCopying(arr[:mid], compare, counter)
+	right := mergeSortCopying(arr[mid:], compare, counter)
+
+	result := make([]T, len(arr))
+	counter.Allocate(len(result))
+	mergeInto(result, left, right, compare, counter, nil, 0)
+	return result
+}
+
+// mergeSortBuffered performs the recursive top-down Merge Sort in-place.
+// buf must hold at least len(arr)/2 elements; only the left half is copied out before each merge.
+func mergeSortBuffered[T any](arr, buf []T, compare func(a, b T) int, counter *pkg.OperationCounter) {
+	counter.Enter()
+	defer counter.Exit()
+
+	if len(arr) <= 1 {
+		return
+	}
+
+	mid := len(arr) / 2
+	mergeSortBuffered(arr[:mid], buf, compare, counter)
+	mergeSortBuffered(arr[mid:], buf, compare, counter)
+
+	left := buf[:mid]
+	copy(left, arr[:mid])
+	counter.Write(mid)
+
+	// Merging back into arr is safe: the next write position never passes
+	// the next unread element of the right half.
+	mergeInto(arr, left, arr[mid:], compare, counter, nil, 0)
+}
+
+// mergeSortRange sorts arr[low:high] like mergeSortBuffered, keeping absolute indices
+// so that callback can be called with the bounds of every merged range and tracer can
+// record every event at its position in arr. Both report ranges with inclusive bounds.
+// callback and tracer may be nil.
+func mergeSortRange[T any](arr, buf []T, low, high int, compare func(a, b T) int, callback func([]T, int, int), tracer *pkg.Tracer) {
+	tracer.Enter(low, high-1)
+	defer tracer.Exit(low, high-1)
+
+	if high-low <= 1 {
+		return
+	}
+
+	mid := low + (high-low)/2
+	mergeSortRange(arr, buf, low, mid, compare, callback, tracer)
+	mergeSortRange(arr, buf, mid, high, compare, callback, tracer)
+
+	tracer.MergeBegin(low, high-1)
+	left := buf[:mid-low]
+	copy(left, arr[low:mid])
+	mergeInto(arr[low:high], left, arr[mid:high], compare, nil, tracer, low)
+	tracer.MergeEnd(low, high-1)
+
+	if callback != nil {
+		callback(arr, low, high-1)
+	}
+}
+
+// mergeSortBottomUp sorts arr in-place by merging runs of doubling width.
+// Passes alternate between arr and one auxiliary buffer, copying back at the end if needed.
+func mergeSortBottomUp[T any](arr []T, compare func(a, b T) int, counter *pkg.OperationCounter) {
+	n := len(arr)
+	if n <= 1 {
+		return
+	}
+
+	src, dst := arr, make([]T, n)
+	counter.Allocate(n)
+
+	for width := 1; width < n; width *= 2 {
+		for low := 0; low < n; low += 2 * width {
+			mid := min(low+width, n)
+			high := min(low+2*width, n)
+			mergeInto(dst[low:high], src[low:mid], src[mid:high], compare, counter, nil, 0)
+		}
+		src, dst = dst, src
+	}
+
+	// After an odd number of passes the sorted data sits in the buffer.
+	if &src[0] != &arr[0] {
+		copy(arr, src)
+		counter.Write(n)
+	}
+}
+
+// mergeInto merges the sorted slices left and right into dst, which must have room for both.
+// Ties are taken from left first so that the sort stays stable.
+// Every element placed in dst is recorded as one write.
+// tracer may be nil. It records the merge as if dst started at index low of the traced array,
+// with left copied out of dst[:len(left)] and right being dst[len(left):], the layout of an
+// in-place merge; left is compared at the position it was copied from.
+func mergeInto[T any](dst, left, right []T, compare func(a, b T) int, counter *pkg.OperationCounter, tracer *pkg.Tracer, low int) {
+	i, j, k := 0, 0, 0
+
+	for i < len(left) && j < len(right) {
+		tracer.Compare(low+i, low+len(left)+j)
+		if compare(left[i], right[j]) <= 0 {
+			dst[k] = left[i]
+			i++
+		} else {
+			dst[k] = right[j]
+			j++
+		}
+		pkg.RecordWrite(tracer, low+k, dst[k])
+		k++
+	}
+
+	tail := k
+	k += copy(dst[k:], left[i:])
+	copy(dst[k:], right[j:])
+	counter.Write(len(dst))
+
+	if tracer != nil {
+		for ; tail < len(dst); tail++ {
+			pkg.RecordWrite(tracer, low+tail, dst[tail])
+		}
+	}
+}
@@ -0,0 +1,293 @@
+package merge_sort
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/JoaoVitor615/algorithms-in-go/pkg"
+)
+
+// arrayMergeSorts lists every array Merge Sort variant as a function returning the sorted values.
+var arrayMergeSorts = []struct {
+	name    string
+	copying bool // leaves its input untouched
+	sort    func([]int) []int
+}{
+	{"MergeSortArray", true, MergeSortArray},
+	{"MergeSortArrayOrdered", true, MergeSortArrayOrdered[int]},
+	{"MergeSortArrayFunc", true, func(arr []int) []int { return MergeSortArrayFunc(arr, cmp.Compare[int]) }},
+	{"MergeSortArrayBuffered", true, MergeSortArrayBuffered},
+	{"MergeSortArrayBottomUp", true, MergeSortArrayBottomUp},
+	{"MergeSortArrayBottomUpFunc", true, func(arr []int) []int { return MergeSortArrayBottomUpFunc(arr, cmp.Compare[int]) }},
+	{"MergeSortArrayInPlace", false, func(arr []int) []int { MergeSortArrayInPlace(arr); return arr }},
+	{"MergeSortArrayBottomUpInPlace", false, func(arr []int) []int { MergeSortArrayBottomUpInPlace(arr); return arr }},
+}
+
+// TestMergeSortArray re-runs the MergeSort table against every array variant.
+func TestMergeSortArray(t *testing.T) {
+	for _, variant := range arrayMergeSorts {
+		for _, tc := range mergeSortTestCases {
+			t.Run(variant.name+"/"+tc.name, func(t *testing.T) {
+				input := slices.Clone(tc.input)
+
+				result := variant.sort(input)
+				if !reflect.DeepEqual(result, tc.expected) {
+					t.Errorf("%s(%v) = %v; want %v", variant.name, tc.input, result, tc.expected)
+				}
+				if variant.copying && !reflect.DeepEqual(input, tc.input) {
+					t.Errorf("%s modified its input to %v", variant.name, input)
+				}
+			})
+		}
+	}
+}
+
+// TestMergeSortArrayRandom checks every array variant against slices.Sort on sizes around powers of two.
+func TestMergeSortArrayRandom(t *testing.T) {
+	generator := pkg.NewRandomGeneratorWithSeed(42)
+
+	for _, size := range []int{2, 3, 7, 8, 9, 31, 32, 33, 1000} {
+		input := generator.GenerateIntSliceDefault(size)
+		expected := slices.Clone(input)
+		slices.Sort(expected)
+
+		for _, variant := range arrayMergeSorts {
+			t.Run(fmt.Sprintf("%s/%d", variant.name, size), func(t *testing.T) {
+				if result := variant.sort(slices.Clone(input)); !reflect.DeepEqual(result, expected) {
+					t.Errorf("%s did not sort %d random numbers", variant.name, size)
+				}
+			})
+		}
+	}
+}
+
+// TestMergeSortArrayStable checks that every comparator variant keeps the order of equal keys.
+func TestMergeSortArrayStable(t *testing.T) {
+	type record struct {
+		key   int
+		label string
+	}
+	input := []record{{3, "a"}, {1, "b"}, {3, "c"}, {2, "d"}, {3, "e"}, {1, "f"}, {2, "g"}}
+	expected := []record{{1, "b"}, {1, "f"}, {2, "d"}, {2, "g"}, {3, "a"}, {3, "c"}, {3, "e"}}
+	byKey := func(a, b record) int { return cmp.Compare(a.key, b.key) }
+
+	sorts := map[string]func([]record) []record{
+		"MergeSortArrayFunc":         func(arr []record) []record { return MergeSortArrayFunc(arr, byKey) },
+		"MergeSortArrayBottomUpFunc": func(arr []record) []record { return MergeSortArrayBottomUpFunc(arr, byKey) },
+		"MergeSortArrayInPlaceFunc": func(arr []record) []record {
+			MergeSortArrayInPlaceFunc(arr, byKey)
+			return arr
+		},
+		"MergeSortArrayBottomUpInPlaceFunc": func(arr []record) []record {
+			MergeSortArrayBottomUpInPlaceFunc(arr, byKey)
+			return arr
+		},
+	}
+
+	for name, sort := range sorts {
+		if result := sort(slices.Clone(input)); !reflect.DeepEqual(result, expected) {
+			t.Errorf("%s(%v) = %v; want %v", name, input, result, expected)
+		}
+	}
+}
+
+// TestMergeSortArrayInstrumented tests the operation counts of the instrumented array versions.
+func TestMergeSortArrayInstrumented(t *testing.T) {
+	input := []int{8, 7, 6, 5, 4, 3, 2, 1}
+	expected := []int{1, 2, 3, 4, 5, 6, 7, 8}
+
+	t.Run("Top-down", func(t *testing.T) {
+		counter := pkg.NewOperationCounter()
+		if result := MergeSortArrayInstrumented(input, counter); !reflect.DeepEqual(result, expected) {
+			t.Errorf("MergeSortArrayInstrumented(%v) = %v; want %v", input, result, expected)
+		}
+
+		// Reverse sorted halves always exhaust one side first: n/2 comparisons per level.
+		if counter.Comparisons != 12 {
+			t.Errorf("counted %d comparisons; want 12", counter.Comparisons)
+		}
+		// Every level writes each element once.
+		if counter.Writes != 24 {
+			t.Errorf("counted %d writes; want 24", counter.Writes)
+		}
+		if counter.MaxDepth != 4 {
+			t.Errorf("reached recursion depth %d; want 4", counter.MaxDepth)
+		}
+		// One output slice per merge.
+		if counter.Allocations != int64(len(input)-1) {
+			t.Errorf("counted %d allocations; want %d", counter.Allocations, len(input)-1)
+		}
+	})
+
+	t.Run("Buffered", func(t *testing.T) {
+		counter := pkg.NewOperationCounter()
+		if result := MergeSortArrayBufferedInstrumented(input, counter); !reflect.DeepEqual(result, expected) {
+			t.Errorf("MergeSortArrayBufferedInstrumented(%v) = %v; want %v", input, result, expected)
+		}
+
+		if counter.Comparisons != 12 {
+			t.Errorf("counted %d comparisons; want 12", counter.Comparisons)
+		}
+		// Every level copies its left halves out, n/2 writes, then merges n elements back.
+		if counter.Writes != 36 {
+			t.Errorf("counted %d writes; want 36", counter.Writes)
+		}
+		// The result copy and one buffer of n/2, whatever the number of merges.
+		if counter.Allocations != 2 || counter.AllocatedElements != 12 {
+			t.Errorf("counted %d allocations of %d elements; want 2 of 12", counter.Allocations, counter.AllocatedElements)
+		}
+	})
+
+	t.Run("Bottom-up", func(t *testing.T) {
+		counter := pkg.NewOperationCounter()
+		if result := MergeSortArrayBottomUpInstrumented(input, counter); !reflect.DeepEqual(result, expected) {
+			t.Errorf("MergeSortArrayBottomUpInstrumented(%v) = %v; want %v", input, result, expected)
+		}
+
+		if counter.Comparisons != 12 {
+			t.Errorf("counted %d comparisons; want 12", counter.Comparisons)
+		}
+		// Three passes of 8 writes, then the copy back after the odd pass count.
+		if counter.Writes != 32 {
+			t.Errorf("counted %d writes; want 32", counter.Writes)
+		}
+		if counter.MaxDepth != 0 {
+			t.Errorf("reached recursion depth %d; want 0", counter.MaxDepth)
+		}
+		// The result copy and one buffer.
+		if counter.Allocations != 2 {
+			t.Errorf("counted %d allocations; want 2", counter.Allocations)
+		}
+	})
+}
+
+// TestMergeSortArrayAllocations tests that the copying variant allocates one slice per merge and none per leaf,
+// and that a single element is still returned as a copy.
+func TestMergeSortArrayAllocations(t *testing.T) {
+	input := pkg.NewRandomGeneratorWithSeed(7).GenerateIntSliceDefault(64)
+
+	allocs := testing.AllocsPerRun(10, func() {
+		MergeSortArray(input)
+	})
+	if want := float64(len(input) - 1); allocs != want {
+		t.Errorf("MergeSortArray allocated %v times; want %v, one per merge", allocs, want)
+	}
+
+	single := []int{42}
+	result := MergeSortArray(single)
+	result[0] = 7
+	if single[0] != 42 {
+		t.Error("MergeSortArray returned its single-element input instead of a copy")
+	}
+}
+
+// TestMergeSortArrayTraced tests that the trace replays to the sorted result and agrees with the operation counts.
+func TestMergeSortArrayTraced(t *testing.T) {
+	for _, tc := range mergeSortTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			counter := pkg.NewOperationCounter()
+			expected := MergeSortArrayBufferedInstrumented(tc.input, counter)
+
+			if result := MergeSortArrayTraced(tc.input, nil); !reflect.DeepEqual(result, expected) {
+				t.Errorf("MergeSortArrayTraced(%v, nil) = %v; want %v", tc.input, result, expected)
+			}
+
+			summary, err := pkg.SummarizeTrace(pkg.RecordTrace("merge-array", MergeSortArrayTraced, tc.input))
+			if err != nil {
+				t.Fatalf("SummarizeTrace() error = %v", err)
+			}
+			if !reflect.DeepEqual(summary.Result, expected) || !summary.Sorted {
+				t.Errorf("replayed trace ends with %v; want %v", summary.Result, expected)
+			}
+			// The copies into the buffer are not array writes, so only comparisons and depth can match.
+			if summary.Counts[pkg.TraceCompare] != int(counter.Comparisons) || summary.MaxDepth != counter.MaxDepth {
+				t.Errorf("traced %d comparisons at depth %d; counted %d at depth %d",
+					summary.Counts[pkg.TraceCompare], summary.MaxDepth, counter.Comparisons, counter.MaxDepth)
+			}
+			if merges := max(len(tc.input)-1, 0); summary.Counts[pkg.TraceMergeBegin] != merges || summary.Counts[pkg.TraceMergeEnd] != merges {
+				t.Errorf("traced %d merge-begin and %d merge-end events; want %d of each",
+					summary.Counts[pkg.TraceMergeBegin], summary.Counts[pkg.TraceMergeEnd], merges)
+			}
+		})
+	}
+}
+
+// BenchmarkMergeSortArray compares the copying and buffer-reusing array variants.
+func BenchmarkMergeSortArray(b *testing.B) {
+	data := pkg.NewRandomGeneratorWithSeed(42).GenerateIntSliceDefault(10000)
+
+	for _, variant := range arrayMergeSorts {
+		b.Run(variant.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				variant.sort(slices.Clone(data))
+			}
+		})
+	}
+}
+
+// FuzzMergeSortArray checks the sort properties of every array Merge Sort variant on fuzzed inputs.
+func FuzzMergeSortArray(f *testing.F) {
+	for _, tc := range mergeSortTestCases {
+		f.Add(pkg.BytesFromInts(tc.input))
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		input := pkg.IntsFromBytes(data)
+		checks := map[string]error{
+			"NewArraySorter":         pkg.CheckSorter(NewArraySorter(pkg.NewOperationCounter()), input),
+			"NewArrayBottomUpSorter": pkg.CheckSorter(NewArrayBottomUpSorter(pkg.NewOperationCounter()), input),
+		}
+		for _, s := range arrayMergeSorts {
+			if s.copying {
+				checks[s.name] = pkg.CheckSort(s.sort, input)
+			} else {
+				checks[s.name] = pkg.CheckSortInPlace(func(arr []int) { s.sort(arr) }, input)
+			}
+		}
+		for name, err := range checks {
+			if err != nil {
+				t.Errorf("%s: %v", name, err)
+			}
+		}
+	})
+}
+
+// TestMergeSortArrayWithCallback tests that every callback reports a merged, sorted range,
+// one per merge, ending with the whole array.
+func TestMergeSortArrayWithCallback(t *testing.T) {
+	for _, tc := range mergeSortTestCases {
+		merges := 0
+		last := [2]int{-1, -1}
+		result := MergeSortArrayWithCallback(tc.input, func(arr []int, low, high int) {
+			merges++
+			last = [2]int{low, high}
+			if low < 0 || high >= len(arr) || low >= high {
+				t.Errorf("%s: invalid merged range [%d, %d] for length %d", tc.name, low, high, len(arr))
+				return
+			}
+			if !slices.IsSorted(arr[low : high+1]) {
+				t.Errorf("%s: merged range [%d, %d] of %v is not sorted", tc.name, low, high, arr)
+			}
+		})
+
+		if !reflect.DeepEqual(result, tc.expected) {
+			t.Errorf("%s: MergeSortArrayWithCallback(%v) = %v; want %v", tc.name, tc.input, result, tc.expected)
+		}
+		if len(tc.input) > 1 {
+			if merges != len(tc.input)-1 {
+				t.Errorf("%s: %d merges; want %d", tc.name, merges, len(tc.input)-1)
+			}
+			if last != [2]int{0, len(tc.input) - 1} {
+				t.Errorf("%s: last merge covered %v; want the whole array", tc.name, last)
+			}
+		}
+	}
+
+	// A nil callback must not panic.
+	if result := MergeSortArrayWithCallback([]int{3, 1, 2}, nil); !reflect.DeepEqual(result, []int{1, 2, 3}) {
+		t.Errorf("MergeSortArrayWithCallback with nil callback = %v; want [1 2 3]", result)
+	}
+}
@@ -0,0 +1,112 @@
+package merge_sort
+
+import (
+	"cmp"
+
+	"github.com/JoaoVitor615/algorithms-in-go/pkg"
+)
+
+// MergeSortBottomUp sorts a linked list using iterative bottom-up Merge Sort.
+// It merges runs of 1, 2, 4... nodes in place, with no recursion and O(1) extra space.
+// Like MergeSort it relinks the input nodes, so the list must be read from the returned head.
+func MergeSortBottomUp(head *Node) *Node {
+	return MergeSortBottomUpOrdered(head)
+}
+
+// MergeSortBottomUpInstrumented sorts a linked list like MergeSortBottomUp and records
+// the comparisons and link writes it performs in counter.
+func MergeSortBottomUpInstrumented(head *Node, counter *pkg.OperationCounter) *Node {
+	return mergeSortBottomUpList(head, pkg.CountComparisons(counter, cmp.Compare[int]), counter)
+}
+
+// NewBottomUpSorter returns a pkg.Sorter that runs MergeSortBottomUp on a linked list built from
+// the input and records the comparisons and link writes it performs in counter.
+// counter may be nil when the caller does not need operation counts.
+func NewBottomUpSorter(counter *pkg.OperationCounter) pkg.Sorter {
+	return pkg.SorterFunc(func(arr []int) {
+		copyList(arr, mergeSortBottomUpList(NewList(arr), pkg.CountComparisons(counter, cmp.Compare[int]), counter))
+	})
+}
+
+// MergeSortBottomUpOrdered sorts a linked list of any ordered type using bottom-up Merge Sort.
+func MergeSortBottomUpOrdered[T cmp.Ordered](head *ListNode[T]) *ListNode[T] {
+	return MergeSortBottomUpFunc(head, cmp.Compare[T])
+}
+
+// MergeSortBottomUpFunc sorts a linked list using bottom-up Merge Sort and a comparator.
+// Equal elements keep their original relative order.
+func MergeSortBottomUpFunc[T any](head *ListNode[T], compare func(a, b T) int) *ListNode[T] {
+	return mergeSortBottomUpList(head, compare, nil)
+}
+
+// mergeSortBottomUpList performs one pass per run width, cutting the list into pairs of runs
+// and appending their merge to the sorted part.
+// counter may be nil when the caller does not need operation counts.
+func mergeSortBottomUpList[T any](head *ListNode[T], compare func(a, b T) int, counter *pkg.OperationCounter) *ListNode[T] {
+	length := 0
+	for node := head; node != nil; node = node.Next {
+		length++
+	}
+	if length <= 1 {
+		return head
+	}
+
+	dummy := ListNode[T]{Next: head}
+	for width := 1; width < length; width *= 2 {
+		tail := &dummy
+		current := dummy.Next
+
+		for current != nil {
+			left := current
+			right := splitAfter(left, width, counter)
+			current = splitAfter(right, width, counter)
+			tail = mergeAfter(tail, left, right, compare, counter)
+		}
+	}
+
+	return dummy.Next
+}
+
+// splitAfter cuts the list after its first size nodes and returns the remainder.
+// It returns nil when the list has size nodes or fewer.
+func splitAfter[T any](head *ListNode[T], size int, counter *pkg.OperationCounter) *ListNode[T] {
+	for i := 1; head != nil && i < size; i++ {
+		head = head.Next
+	}
+	if head == nil || head.Next == nil {
+		return nil
+	}
+
+	rest := head.Next
+	head.Next = nil
+	counter.Write(1)
+	return rest
+}
+
+// mergeAfter links the merge of the sorted lists l1 and l2 after tail and returns the new tail.
+// Ties are taken from l1 first so that the sort stays stable.
+func mergeAfter[T any](tail, l1, l2 *ListNode[T], compare func(a, b T) int, counter *pkg.OperationCounter) *ListNode[T] {
+	for l1 != nil && l2 != nil {
+		if compare(l1.Value, l2.Value) <= 0 {
+			tail.Next = l1
+			l1 = l1.Next
+		} else {
+			tail.Next = l2
+			l2 = l2.Next
+		}
+		counter.Write(1)
+		tail = tail.Next
+	}
+
+	if l1 != nil {
+		tail.Next = l1
+	} else {
+		tail.Next = l2
+	}
+	counter.Write(1)
+
+	for tail.Next != nil {
+		tail = tail.Next
+	}
+	return tail
+}
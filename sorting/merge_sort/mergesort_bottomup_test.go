@@ -0,0 +1,120 @@
+package merge_sort
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/JoaoVitor615/algorithms-in-go/pkg"
+)
+
+// TestMergeSortBottomUp re-runs the MergeSort table against the bottom-up versions.
+func TestMergeSortBottomUp(t *testing.T) {
+	sorts := []struct {
+		name string
+		sort func(*Node) *Node
+	}{
+		{"MergeSortBottomUp", MergeSortBottomUp},
+		{"MergeSortBottomUpOrdered", MergeSortBottomUpOrdered[int]},
+		{"MergeSortBottomUpFunc", func(head *Node) *Node { return MergeSortBottomUpFunc(head, cmp.Compare[int]) }},
+	}
+
+	for _, variant := range sorts {
+		for _, tc := range mergeSortTestCases {
+			t.Run(variant.name+"/"+tc.name, func(t *testing.T) {
+				sortedList := variant.sort(NewList(tc.input))
+
+				expectedList := NewList(tc.expected)
+				if !compareLists(sortedList, expectedList) {
+					t.Errorf("%s(%v) = %v; want %v", variant.name, tc.input, listToString(sortedList), listToString(expectedList))
+				}
+			})
+		}
+	}
+}
+
+// TestMergeSortBottomUpRandom checks the bottom-up version against slices.Sort on sizes around powers of two.
+func TestMergeSortBottomUpRandom(t *testing.T) {
+	generator := pkg.NewRandomGeneratorWithSeed(42)
+
+	for _, size := range []int{2, 3, 7, 8, 9, 31, 32, 33, 1000} {
+		t.Run(fmt.Sprintf("%d", size), func(t *testing.T) {
+			input := generator.GenerateIntSliceDefault(size)
+			expected := slices.Clone(input)
+			slices.Sort(expected)
+
+			if result := ListValues(MergeSortBottomUp(NewList(input))); !reflect.DeepEqual(result, expected) {
+				t.Errorf("MergeSortBottomUp did not sort %d random numbers", size)
+			}
+		})
+	}
+}
+
+// TestMergeSortBottomUpStable checks that equal keys keep their original order.
+func TestMergeSortBottomUpStable(t *testing.T) {
+	type record struct {
+		key   int
+		label string
+	}
+	input := []record{{3, "a"}, {1, "b"}, {3, "c"}, {2, "d"}, {3, "e"}, {1, "f"}, {2, "g"}}
+	expected := []record{{1, "b"}, {1, "f"}, {2, "d"}, {2, "g"}, {3, "a"}, {3, "c"}, {3, "e"}}
+
+	sorted := MergeSortBottomUpFunc(NewList(input), func(a, b record) int {
+		return cmp.Compare(a.key, b.key)
+	})
+	if result := ListValues(sorted); !reflect.DeepEqual(result, expected) {
+		t.Errorf("MergeSortBottomUpFunc(%v) = %v; want %v", input, result, expected)
+	}
+}
+
+// TestMergeSortBottomUpInstrumented tests the operation counts of the instrumented bottom-up version.
+func TestMergeSortBottomUpInstrumented(t *testing.T) {
+	input := []int{8, 7, 6, 5, 4, 3, 2, 1}
+	counter := pkg.NewOperationCounter()
+
+	sortedList := MergeSortBottomUpInstrumented(NewList(input), counter)
+	if result := ListValues(sortedList); !reflect.DeepEqual(result, []int{1, 2, 3, 4, 5, 6, 7, 8}) {
+		t.Errorf("MergeSortBottomUpInstrumented(%v) = %v; want sorted output", input, result)
+	}
+
+	// Same merges as the recursive version: n/2 comparisons per pass.
+	if counter.Comparisons != 12 {
+		t.Errorf("counted %d comparisons; want 12", counter.Comparisons)
+	}
+	// No recursion and no helper nodes.
+	if counter.MaxDepth != 0 {
+		t.Errorf("reached recursion depth %d; want 0", counter.MaxDepth)
+	}
+	if counter.Allocations != 0 {
+		t.Errorf("counted %d allocations; want 0", counter.Allocations)
+	}
+}
+
+// BenchmarkMergeSortList compares the recursive and bottom-up list versions on large lists.
+func BenchmarkMergeSortList(b *testing.B) {
+	sorts := []struct {
+		name string
+		sort func(*Node) *Node
+	}{
+		{"Recursive", MergeSort},
+		{"BottomUp", MergeSortBottomUp},
+	}
+
+	for _, size := range []int{10_000, 1_000_000, 4_000_000} {
+		data := pkg.NewRandomGeneratorWithSeed(42).GenerateIntSlice(size, 1, size)
+
+		for _, variant := range sorts {
+			b.Run(fmt.Sprintf("%s/size_%d", variant.name, size), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					b.StopTimer()
+					head := NewList(data)
+					b.StartTimer()
+
+					variant.sort(head)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package merge_sort
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+
+	"github.com/JoaoVitor615/algorithms-in-go/pkg"
+)
+
+// MergeSortArrayParallel sorts an array using top-down Merge Sort, sorting the left and right
+// halves of ranges above options.Threshold on separate goroutines.
+// At most options.Workers goroutines sort at the same time; unset options use pkg.DefaultParallelOptions.
+func MergeSortArrayParallel(arr []int, options pkg.ParallelOptions) []int {
+	return MergeSortArrayParallelFunc(arr, options, cmp.Compare[int])
+}
+
+// MergeSortArrayParallelFunc sorts a slice like MergeSortArrayParallel using a comparator.
+// Equal elements keep their original relative order.
+func MergeSortArrayParallelFunc[T any](arr []T, options pkg.ParallelOptions, compare func(a, b T) int) []T {
+	if len(arr) <= 1 {
+		return slices.Clone(arr)
+	}
+
+	result := make([]T, len(arr))
+	copy(result, arr)
+
+	options = options.Normalize()
+	mergeSortParallel(result, make([]T, len(arr)), compare, options.Threshold, pkg.NewWorkerLimiter(options.Workers))
+	return result
+}
+
+// NewArrayParallelSorter returns a pkg.Sorter that runs MergeSortArrayParallel with options.
+// The parallel sort is not instrumented, as a shared counter would serialize its goroutines.
+func NewArrayParallelSorter(options pkg.ParallelOptions) pkg.Sorter {
+	return pkg.SorterFunc(func(arr []int) {
+		if len(arr) <= 1 {
+			return
+		}
+		options := options.Normalize()
+		mergeSortParallel(arr, make([]int, len(arr)), cmp.Compare[int], options.Threshold, pkg.NewWorkerLimiter(options.Workers))
+	})
+}
+
+// mergeSortParallel sorts arr in-place, forking the left half when the range reaches threshold.
+// buf must be as long as arr so that both halves get their own part of it.
+func mergeSortParallel[T any](arr, buf []T, compare func(a, b T) int, threshold int, limiter *pkg.WorkerLimiter) {
+	if len(arr) <= 1 || len(arr) < threshold {
+		mergeSortBuffered(arr, buf, compare, nil)
+		return
+	}
+
+	mid := len(arr) / 2
+
+	var wg sync.WaitGroup
+	limiter.Go(&wg, func() {
+		mergeSortParallel(arr[:mid], buf[:mid], compare, threshold, limiter)
+	})
+	mergeSortParallel(arr[mid:], buf[mid:], compare, threshold, limiter)
+	wg.Wait()
+
+	left := buf[:mid]
+	copy(left, arr[:mid])
+	mergeInto(arr, left, arr[mid:], compare, nil, nil, 0)
+}
@@ -0,0 +1,109 @@
+package merge_sort
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/JoaoVitor615/algorithms-in-go/pkg"
+)
+
+// parallelTestOptions covers sequential fallback, tiny thresholds that fork at every level and the defaults.
+var parallelTestOptions = []pkg.ParallelOptions{
+	{Threshold: 2, Workers: 1},
+	{Threshold: 2, Workers: 2},
+	{Threshold: 2, Workers: 8},
+	{Threshold: 64, Workers: 4},
+	{},
+}
+
+// TestMergeSortArrayParallel re-runs the MergeSort table and random inputs with several worker settings.
+func TestMergeSortArrayParallel(t *testing.T) {
+	generator := pkg.NewRandomGeneratorWithSeed(42)
+	random := generator.GenerateIntSliceDefault(10000)
+	expectedRandom := slices.Clone(random)
+	slices.Sort(expectedRandom)
+
+	for _, options := range parallelTestOptions {
+		t.Run(fmt.Sprintf("threshold_%d/workers_%d", options.Threshold, options.Workers), func(t *testing.T) {
+			for _, tc := range mergeSortTestCases {
+				input := slices.Clone(tc.input)
+				if result := MergeSortArrayParallel(input, options); !reflect.DeepEqual(result, tc.expected) {
+					t.Errorf("%s: MergeSortArrayParallel(%v) = %v; want %v", tc.name, tc.input, result, tc.expected)
+				}
+				if !reflect.DeepEqual(input, tc.input) {
+					t.Errorf("%s: MergeSortArrayParallel modified its input to %v", tc.name, input)
+				}
+			}
+
+			if result := MergeSortArrayParallel(random, options); !reflect.DeepEqual(result, expectedRandom) {
+				t.Errorf("MergeSortArrayParallel did not sort %d random numbers", len(random))
+			}
+		})
+	}
+}
+
+// TestMergeSortArrayParallelStable checks that forked halves still keep the order of equal keys.
+func TestMergeSortArrayParallelStable(t *testing.T) {
+	type record struct {
+		key   int
+		index int
+	}
+
+	generator := pkg.NewRandomGeneratorWithSeed(7)
+	input := make([]record, 5000)
+	for i, key := range generator.GenerateIntSlice(len(input), 1, 20) {
+		input[i] = record{key: key, index: i}
+	}
+
+	result := MergeSortArrayParallelFunc(input, pkg.ParallelOptions{Threshold: 16, Workers: 4}, func(a, b record) int {
+		return cmp.Compare(a.key, b.key)
+	})
+
+	for i := 1; i < len(result); i++ {
+		previous, current := result[i-1], result[i]
+		if previous.key > current.key || (previous.key == current.key && previous.index > current.index) {
+			t.Fatalf("records %v and %v are out of order", previous, current)
+		}
+	}
+}
+
+// BenchmarkMergeSortArrayParallel compares the parallel and sequential array versions on a million numbers.
+func BenchmarkMergeSortArrayParallel(b *testing.B) {
+	data := pkg.NewRandomGeneratorWithSeed(42).GenerateIntSlice(1_000_000, 1, 1_000_000)
+
+	b.Run("Sequential", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			MergeSortArrayBuffered(data)
+		}
+	})
+	b.Run("Parallel", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			MergeSortArrayParallel(data, pkg.DefaultParallelOptions())
+		}
+	})
+}
+
+// FuzzMergeSortArrayParallel checks the sort properties of parallel Merge Sort on fuzzed inputs,
+// with a threshold small enough to fork on most of them.
+func FuzzMergeSortArrayParallel(f *testing.F) {
+	for _, tc := range mergeSortTestCases {
+		f.Add(pkg.BytesFromInts(tc.input))
+	}
+	options := pkg.ParallelOptions{Threshold: 8, Workers: 4}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		input := pkg.IntsFromBytes(data)
+		checks := map[string]error{
+			"MergeSortArrayParallel": pkg.CheckSort(func(arr []int) []int { return MergeSortArrayParallel(arr, options) }, input),
+			"NewArrayParallelSorter": pkg.CheckSorter(NewArrayParallelSorter(options), input),
+		}
+		for name, err := range checks {
+			if err != nil {
+				t.Errorf("%s: %v", name, err)
+			}
+		}
+	})
+}
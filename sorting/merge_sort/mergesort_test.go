@@ -1,68 +1,72 @@
 package merge_sort
 
 import (
+	"cmp"
 	"fmt"
 	"reflect"
+	"slices"
 	"testing"
+
+	"github.com/JoaoVitor615/algorithms-in-go/pkg"
 )
 
+// mergeSortTestCases is the shared table used by the int and generic MergeSort tests.
+var mergeSortTestCases = []struct {
+	name     string
+	input    []int
+	expected []int
+}{
+	{
+		name:     "Empty list",
+		input:    []int{},
+		expected: []int{},
+	},
+	{
+		name:     "Single element",
+		input:    []int{5},
+		expected: []int{5},
+	},
+	{
+		name:     "Already sorted list",
+		input:    []int{1, 2, 3, 4, 5},
+		expected: []int{1, 2, 3, 4, 5},
+	},
+	{
+		name:     "Reverse sorted list",
+		input:    []int{5, 4, 3, 2, 1},
+		expected: []int{1, 2, 3, 4, 5},
+	},
+	{
+		name:     "Unsorted list with even number of elements",
+		input:    []int{4, 2, 5, 1, 3, 6},
+		expected: []int{1, 2, 3, 4, 5, 6},
+	},
+	{
+		name:     "Unsorted list with odd number of elements",
+		input:    []int{4, 2, 5, 1, 3},
+		expected: []int{1, 2, 3, 4, 5},
+	},
+	{
+		name:     "List with duplicate elements",
+		input:    []int{4, 2, 5, 1, 3, 2, 4},
+		expected: []int{1, 2, 2, 3, 4, 4, 5},
+	},
+}
+
 // TestMergeSort runs unit tests for the MergeSort function.
 func TestMergeSort(t *testing.T) {
-	// Define a series of test cases.
-	testCases := []struct {
-		name     string
-		input    []int
-		expected []int
-	}{
-		{
-			name:     "Empty list",
-			input:    []int{},
-			expected: []int{},
-		},
-		{
-			name:     "Single element",
-			input:    []int{5},
-			expected: []int{5},
-		},
-		{
-			name:     "Already sorted list",
-			input:    []int{1, 2, 3, 4, 5},
-			expected: []int{1, 2, 3, 4, 5},
-		},
-		{
-			name:     "Reverse sorted list",
-			input:    []int{5, 4, 3, 2, 1},
-			expected: []int{1, 2, 3, 4, 5},
-		},
-		{
-			name:     "Unsorted list with even number of elements",
-			input:    []int{4, 2, 5, 1, 3, 6},
-			expected: []int{1, 2, 3, 4, 5, 6},
-		},
-		{
-			name:     "Unsorted list with odd number of elements",
-			input:    []int{4, 2, 5, 1, 3},
-			expected: []int{1, 2, 3, 4, 5},
-		},
-		{
-			name:     "List with duplicate elements",
-			input:    []int{4, 2, 5, 1, 3, 2, 4},
-			expected: []int{1, 2, 2, 3, 4, 4, 5},
-		},
-	}
-
 	// Iterate through each test case.
-	for _, tc := range testCases {
+	for _, tc := range mergeSortTestCases {
 		// Run the test in a subtest for clear output.
 		t.Run(tc.name, func(t *testing.T) {
 			// Create a linked list from the input slice.
-			inputList := createList(tc.input)
+			inputList := NewList(tc.input)
 
 			// Call the MergeSort function on the list.
 			sortedList := MergeSort(inputList)
 
 			// Create the expected linked list for comparison.
-			expectedList := createList(tc.expected)
+			expectedList := NewList(tc.expected)
 
 			// Compare the sorted list with the expected list.
 			if !compareLists(sortedList, expectedList) {
@@ -72,40 +76,136 @@ func TestMergeSort(t *testing.T) {
 	}
 }
 
-// createList is a helper function to build a linked list from a slice of integers.
-func createList(vals []int) *Node {
-	if len(vals) == 0 {
-		return nil
+// TestMergeSortOrdered re-runs the MergeSort table against the generic ordered version.
+func TestMergeSortOrdered(t *testing.T) {
+	for _, tc := range mergeSortTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sortedList := MergeSortOrdered(NewList(tc.input))
+
+			expectedList := NewList(tc.expected)
+			if !compareLists(sortedList, expectedList) {
+				t.Errorf("MergeSortOrdered(%v) = %v; want %v", tc.input, listToString(sortedList), listToString(expectedList))
+			}
+		})
 	}
-	head := &Node{Value: vals[0]}
-	current := head
-	for i := 1; i < len(vals); i++ {
-		current.Next = &Node{Value: vals[i]}
-		current = current.Next
+}
+
+// TestMergeSortFunc re-runs the MergeSort table against the comparator version.
+func TestMergeSortFunc(t *testing.T) {
+	for _, tc := range mergeSortTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sortedList := MergeSortFunc(NewList(tc.input), cmp.Compare[int])
+
+			expectedList := NewList(tc.expected)
+			if !compareLists(sortedList, expectedList) {
+				t.Errorf("MergeSortFunc(%v) = %v; want %v", tc.input, listToString(sortedList), listToString(expectedList))
+			}
+		})
+	}
+}
+
+// TestMergeSortGenericTypes tests the generic versions with non-int element types.
+func TestMergeSortGenericTypes(t *testing.T) {
+	t.Run("Strings", func(t *testing.T) {
+		input := []string{"pear", "apple", "fig", "banana"}
+		expected := []string{"apple", "banana", "fig", "pear"}
+
+		result := ListValues(MergeSortOrdered(NewList(input)))
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("MergeSortOrdered(%v) = %v; want %v", input, result, expected)
+		}
+	})
+
+	t.Run("Structs keep order of equal keys", func(t *testing.T) {
+		type record struct {
+			key   int
+			label string
+		}
+		input := []record{{3, "a"}, {1, "b"}, {3, "c"}, {2, "d"}, {3, "e"}}
+		expected := []record{{1, "b"}, {2, "d"}, {3, "a"}, {3, "c"}, {3, "e"}}
+
+		sorted := MergeSortFunc(NewList(input), func(a, b record) int {
+			return cmp.Compare(a.key, b.key)
+		})
+		if result := ListValues(sorted); !reflect.DeepEqual(result, expected) {
+			t.Errorf("MergeSortFunc(%v) = %v; want %v", input, result, expected)
+		}
+	})
+}
+
+// TestMergeSortInstrumented tests the operation counts of the instrumented version.
+func TestMergeSortInstrumented(t *testing.T) {
+	input := []int{8, 7, 6, 5, 4, 3, 2, 1}
+	counter := pkg.NewOperationCounter()
+
+	sortedList := MergeSortInstrumented(NewList(input), counter)
+	if result := ListValues(sortedList); !reflect.DeepEqual(result, []int{1, 2, 3, 4, 5, 6, 7, 8}) {
+		t.Errorf("MergeSortInstrumented(%v) = %v; want sorted output", input, result)
+	}
+
+	// Reverse sorted halves always exhaust one side first: n/2 comparisons per level.
+	if counter.Comparisons != 12 {
+		t.Errorf("counted %d comparisons; want 12", counter.Comparisons)
+	}
+	// One level per halving plus the single element leaves.
+	if counter.MaxDepth != 4 {
+		t.Errorf("reached recursion depth %d; want 4", counter.MaxDepth)
+	}
+	// One dummy node per merge.
+	if counter.Allocations != int64(len(input)-1) {
+		t.Errorf("counted %d allocations; want %d", counter.Allocations, len(input)-1)
+	}
+}
+
+// TestMergeSortWithCallback tests that the list sort reports every merge with the whole list in its current order.
+func TestMergeSortWithCallback(t *testing.T) {
+	for _, tc := range mergeSortTestCases {
+		merges := 0
+		last := [2]int{-1, -1}
+		sortedInput := slices.Sorted(slices.Values(tc.input))
+		result := MergeSortWithCallback(NewList(tc.input), func(values []int, low, high int) {
+			merges++
+			last = [2]int{low, high}
+			if low < 0 || high >= len(values) || low >= high {
+				t.Errorf("%s: invalid merged range [%d, %d] for length %d", tc.name, low, high, len(values))
+				return
+			}
+			if !slices.IsSorted(values[low : high+1]) {
+				t.Errorf("%s: merged range [%d, %d] of %v is not sorted", tc.name, low, high, values)
+			}
+			if !slices.Equal(slices.Sorted(slices.Values(values)), sortedInput) {
+				t.Errorf("%s: step values %v are not a permutation of the input %v", tc.name, values, tc.input)
+			}
+		})
+
+		if values := ListValues(result); !slices.Equal(values, tc.expected) {
+			t.Errorf("%s: MergeSortWithCallback(%v) = %v; want %v", tc.name, tc.input, values, tc.expected)
+		}
+		if len(tc.input) > 1 {
+			if merges != len(tc.input)-1 {
+				t.Errorf("%s: %d merges; want %d", tc.name, merges, len(tc.input)-1)
+			}
+			if last != [2]int{0, len(tc.input) - 1} {
+				t.Errorf("%s: last merge covered %v; want the whole list", tc.name, last)
+			}
+		}
+	}
+
+	// A nil callback must not panic.
+	if values := ListValues(MergeSortWithCallback(NewList([]int{3, 1, 2}), nil)); !slices.Equal(values, []int{1, 2, 3}) {
+		t.Errorf("MergeSortWithCallback with nil callback = %v; want [1 2 3]", values)
 	}
-	return head
 }
 
 // compareLists is a helper function to check if two linked lists are equal.
 func compareLists(l1, l2 *Node) bool {
 	// Convert lists to slices and compare them.
 	// This is a robust way to handle the comparison.
-	slice1 := listToSlice(l1)
-	slice2 := listToSlice(l2)
+	slice1 := ListValues(l1)
+	slice2 := ListValues(l2)
 	return reflect.DeepEqual(slice1, slice2)
 }
 
-// listToSlice is a helper function to convert a linked list to a slice.
-func listToSlice(head *Node) []int {
-	var vals []int
-	current := head
-	for current != nil {
-		vals = append(vals, current.Value)
-		current = current.Next
-	}
-	return vals
-}
-
 // listToString is a helper function to format a linked list for error messages.
 func listToString(head *Node) string {
 	var s string
@@ -117,3 +217,29 @@ func listToString(head *Node) string {
 	s += "nil"
 	return s
 }
+
+// FuzzMergeSort checks the sort properties of the linked-list Merge Sorts on fuzzed inputs.
+func FuzzMergeSort(f *testing.F) {
+	for _, tc := range mergeSortTestCases {
+		f.Add(pkg.BytesFromInts(tc.input))
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		input := pkg.IntsFromBytes(data)
+		checks := map[string]error{
+			"MergeSort": pkg.CheckSort(func(arr []int) []int {
+				return ListValues(MergeSort(NewList(arr)))
+			}, input),
+			"MergeSortBottomUp": pkg.CheckSort(func(arr []int) []int {
+				return ListValues(MergeSortBottomUp(NewList(arr)))
+			}, input),
+			"NewSorter":         pkg.CheckSorter(NewSorter(pkg.NewOperationCounter()), input),
+			"NewBottomUpSorter": pkg.CheckSorter(NewBottomUpSorter(pkg.NewOperationCounter()), input),
+		}
+		for name, err := range checks {
+			if err != nil {
+				t.Errorf("%s: %v", name, err)
+			}
+		}
+	})
+}
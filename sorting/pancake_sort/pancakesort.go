@@ -0,0 +1,123 @@
+package pancake_sort
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/JoaoVitor615/algorithms-in-go/pkg"
+)
+
+// PancakeSort sorts an array using the Pancake Sort algorithm
+// The only operation allowed is a flip, which reverses a prefix of the array. Each pass
+// flips the largest unsorted element to the front, then flips it down to its final position
+// Time Complexity: O(n²) in every case, with at most 2n-3 flips
+// Space Complexity: O(1)
+func PancakeSort(arr []int) []int {
+	return PancakeSortOrdered(arr)
+}
+
+// PancakeSortInstrumented sorts an array like PancakeSort and records
+// the comparisons, swaps made by the flips and allocations in counter
+func PancakeSortInstrumented(arr []int, counter *pkg.OperationCounter) []int {
+	if len(arr) <= 1 {
+		return slices.Clone(arr)
+	}
+
+	result := make([]int, len(arr))
+	copy(result, arr)
+	counter.Allocate(len(result))
+
+	pancakeSort(result, pkg.CountComparisons(counter, cmp.Compare[int]), counter, nil)
+	return result
+}
+
+// PancakeSortInPlace sorts an array in-place using the Pancake Sort algorithm
+func PancakeSortInPlace(arr []int) {
+	PancakeSortInPlaceFunc(arr, cmp.Compare[int])
+}
+
+// NewSorter returns a pkg.Sorter that runs PancakeSort and records the operations it performs in counter
+// counter may be nil when the caller does not need operation counts
+func NewSorter(counter *pkg.OperationCounter) pkg.Sorter {
+	return pkg.SorterFunc(func(arr []int) {
+		if len(arr) <= 1 {
+			return
+		}
+		pancakeSort(arr, pkg.CountComparisons(counter, cmp.Compare[int]), counter, nil)
+	})
+}
+
+// PancakeFlips returns the sizes of the prefixes PancakeSort flips to sort arr, in order
+// This is the answer to the pancake problem: sorting a stack using only a spatula
+func PancakeFlips(arr []int) []int {
+	result := make([]int, len(arr))
+	copy(result, arr)
+
+	flips := []int{}
+	pancakeSort(result, cmp.Compare[int], nil, func(size int) {
+		flips = append(flips, size)
+	})
+	return flips
+}
+
+// PancakeSortOrdered sorts a slice of any ordered type (integers, floats, strings)
+// It returns a sorted copy and leaves the original slice untouched
+func PancakeSortOrdered[T cmp.Ordered](arr []T) []T {
+	return PancakeSortFunc(arr, cmp.Compare[T])
+}
+
+// PancakeSortFunc sorts a slice of any type using a comparator function
+// The comparator must return a negative number when a < b, zero when a == b
+// and a positive number when a > b, matching the contract of cmp.Compare
+func PancakeSortFunc[T any](arr []T, compare func(a, b T) int) []T {
+	if len(arr) <= 1 {
+		return slices.Clone(arr)
+	}
+
+	// Make a copy to avoid modifying the original array
+	result := make([]T, len(arr))
+	copy(result, arr)
+
+	pancakeSort(result, compare, nil, nil)
+	return result
+}
+
+// PancakeSortInPlaceFunc sorts a slice in-place using a comparator function
+func PancakeSortInPlaceFunc[T any](arr []T, compare func(a, b T) int) {
+	pancakeSort(arr, compare, nil, nil)
+}
+
+// pancakeSort performs the Pancake Sort in-place, calling onFlip with the size of every flipped prefix
+// counter and onFlip may be nil when the caller does not need them
+func pancakeSort[T any](arr []T, compare func(a, b T) int, counter *pkg.OperationCounter, onFlip func(size int)) {
+	for size := len(arr); size > 1; size-- {
+		maxIndex := 0
+		for i := 1; i < size; i++ {
+			if compare(arr[i], arr[maxIndex]) > 0 {
+				maxIndex = i
+			}
+		}
+
+		// The largest element is already at the bottom of the unsorted stack
+		if maxIndex == size-1 {
+			continue
+		}
+
+		if maxIndex > 0 {
+			flip(arr[:maxIndex+1], counter, onFlip)
+		}
+		flip(arr[:size], counter, onFlip)
+	}
+}
+
+// flip reverses the prefix arr, recording one swap per pair of elements exchanged
+func flip[T any](arr []T, counter *pkg.OperationCounter, onFlip func(size int)) {
+	for i, j := 0, len(arr)-1; i < j; i, j = i+1, j-1 {
+		arr[i], arr[j] = arr[j], arr[i]
+		counter.Swap()
+	}
+
+	if onFlip != nil {
+		onFlip(len(arr))
+	}
+}
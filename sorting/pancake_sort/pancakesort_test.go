@@ -0,0 +1,258 @@
+package pancake_sort
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/JoaoVitor615/algorithms-in-go/pkg"
+)
+
+// pancakeSortTestCases is the shared table used by the int and generic PancakeSort tests.
+var pancakeSortTestCases = []struct {
+	name     string
+	input    []int
+	expected []int
+}{
+	{
+		name:     "Empty array",
+		input:    []int{},
+		expected: []int{},
+	},
+	{
+		name:     "Single element",
+		input:    []int{5},
+		expected: []int{5},
+	},
+	{
+		name:     "Already sorted array",
+		input:    []int{1, 2, 3, 4, 5},
+		expected: []int{1, 2, 3, 4, 5},
+	},
+	{
+		name:     "Reverse sorted array",
+		input:    []int{5, 4, 3, 2, 1},
+		expected: []int{1, 2, 3, 4, 5},
+	},
+	{
+		name:     "Unsorted array with even number of elements",
+		input:    []int{4, 2, 5, 1, 3, 6},
+		expected: []int{1, 2, 3, 4, 5, 6},
+	},
+	{
+		name:     "Array with duplicate elements",
+		input:    []int{4, 2, 5, 1, 3, 2, 4},
+		expected: []int{1, 2, 2, 3, 4, 4, 5},
+	},
+	{
+		name:     "Array with all same elements",
+		input:    []int{3, 3, 3, 3, 3},
+		expected: []int{3, 3, 3, 3, 3},
+	},
+	{
+		name:     "Array with negative numbers",
+		input:    []int{-5, 2, -3, 8, 1, -1},
+		expected: []int{-5, -3, -1, 1, 2, 8},
+	},
+	{
+		name:     "Large random array",
+		input:    []int{64, 34, 25, 12, 22, 11, 90, 88, 76, 50, 42},
+		expected: []int{11, 12, 22, 25, 34, 42, 50, 64, 76, 88, 90},
+	},
+}
+
+// TestPancakeSort runs the shared table against every variant
+func TestPancakeSort(t *testing.T) {
+	sorts := []struct {
+		name string
+		sort func([]int) []int
+	}{
+		{"PancakeSort", PancakeSort},
+		{"PancakeSortInstrumented", func(arr []int) []int { return PancakeSortInstrumented(arr, pkg.NewOperationCounter()) }},
+		{"PancakeSortOrdered", PancakeSortOrdered[int]},
+		{"PancakeSortFunc", func(arr []int) []int { return PancakeSortFunc(arr, cmp.Compare[int]) }},
+		{"PancakeSortInPlace", func(arr []int) []int {
+			result := slices.Clone(arr)
+			PancakeSortInPlace(result)
+			return result
+		}},
+	}
+
+	for _, variant := range sorts {
+		for _, tc := range pancakeSortTestCases {
+			t.Run(variant.name+"/"+tc.name, func(t *testing.T) {
+				input := slices.Clone(tc.input)
+				result := variant.sort(input)
+
+				if !reflect.DeepEqual(result, tc.expected) {
+					t.Errorf("%s(%v) = %v; want %v", variant.name, tc.input, result, tc.expected)
+				}
+				if variant.name != "PancakeSortInPlace" && !reflect.DeepEqual(input, tc.input) {
+					t.Errorf("%s modified its input: got %v, want %v", variant.name, input, tc.input)
+				}
+			})
+		}
+	}
+}
+
+// TestPancakeFlips tests the flip sequences returned for small stacks
+func TestPancakeFlips(t *testing.T) {
+	testCases := []struct {
+		input    []int
+		expected []int
+	}{
+		{[]int{}, []int{}},
+		{[]int{1, 2, 3}, []int{}},
+		{[]int{3, 1, 2}, []int{3, 2}},
+		{[]int{5, 4, 3, 2, 1}, []int{5}},
+		{[]int{2, 4, 1, 3}, []int{2, 4, 3, 2}},
+	}
+
+	for _, tc := range testCases {
+		if result := PancakeFlips(tc.input); !reflect.DeepEqual(result, tc.expected) {
+			t.Errorf("PancakeFlips(%v) = %v; want %v", tc.input, result, tc.expected)
+		}
+	}
+}
+
+// TestPancakeFlipsReplay checks that replaying the flips sorts the input and that at most 2n-3 flips are needed
+func TestPancakeFlipsReplay(t *testing.T) {
+	generator := pkg.NewRandomGeneratorWithSeed(42)
+
+	for _, distribution := range pkg.Distributions() {
+		for _, size := range []int{2, 3, 50, 500} {
+			t.Run(fmt.Sprintf("%v/%d", distribution, size), func(t *testing.T) {
+				input := generator.GenerateDistribution(distribution, size)
+				flips := PancakeFlips(input)
+
+				stack := slices.Clone(input)
+				for _, flip := range flips {
+					slices.Reverse(stack[:flip])
+				}
+
+				if !reflect.DeepEqual(stack, PancakeSort(input)) || !slices.IsSorted(stack) {
+					t.Errorf("replaying %d flips did not sort %d %v numbers", len(flips), size, distribution)
+				}
+				if len(flips) > 2*size-3 {
+					t.Errorf("PancakeFlips made %d flips; want at most %d", len(flips), 2*size-3)
+				}
+			})
+		}
+	}
+}
+
+// TestPancakeSortGenericTypes tests the generic variants with floats, strings and a custom order
+func TestPancakeSortGenericTypes(t *testing.T) {
+	t.Run("Floats", func(t *testing.T) {
+		input := []float64{3.5, -1.25, 2.0, 0.5, -7.75}
+		expected := []float64{-7.75, -1.25, 0.5, 2.0, 3.5}
+
+		if result := PancakeSortOrdered(input); !reflect.DeepEqual(result, expected) {
+			t.Errorf("PancakeSortOrdered(%v) = %v; want %v", input, result, expected)
+		}
+	})
+
+	t.Run("Strings", func(t *testing.T) {
+		input := []string{"pear", "apple", "fig", "banana"}
+		expected := []string{"apple", "banana", "fig", "pear"}
+
+		if result := PancakeSortOrdered(input); !reflect.DeepEqual(result, expected) {
+			t.Errorf("PancakeSortOrdered(%v) = %v; want %v", input, result, expected)
+		}
+	})
+
+	t.Run("Descending comparator", func(t *testing.T) {
+		input := []int{4, 2, 5, 1, 3}
+		expected := []int{5, 4, 3, 2, 1}
+
+		PancakeSortInPlaceFunc(input, func(a, b int) int { return cmp.Compare(b, a) })
+		if !reflect.DeepEqual(input, expected) {
+			t.Errorf("PancakeSortInPlaceFunc with descending comparator = %v; want %v", input, expected)
+		}
+	})
+}
+
+// TestPancakeSortInstrumented tests the operation counts of the instrumented version
+func TestPancakeSortInstrumented(t *testing.T) {
+	testCases := []struct {
+		name        string
+		input       []int
+		comparisons int64
+		swaps       int64
+	}{
+		{
+			name:        "Already sorted array is never flipped",
+			input:       []int{1, 2, 3, 4, 5},
+			comparisons: 10,
+			swaps:       0,
+		},
+		{
+			name:        "Reverse sorted array takes a single flip",
+			input:       []int{5, 4, 3, 2, 1},
+			comparisons: 10,
+			swaps:       2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			counter := pkg.NewOperationCounter()
+			result := PancakeSortInstrumented(tc.input, counter)
+
+			if !reflect.DeepEqual(result, PancakeSort(tc.input)) {
+				t.Errorf("PancakeSortInstrumented(%v) = %v; want sorted output", tc.input, result)
+			}
+			if counter.Comparisons != tc.comparisons || counter.Swaps != tc.swaps || counter.Writes != 2*tc.swaps {
+				t.Errorf("counted %d comparisons, %d swaps and %d writes; want %d, %d and %d",
+					counter.Comparisons, counter.Swaps, counter.Writes, tc.comparisons, tc.swaps, 2*tc.swaps)
+			}
+			if counter.Allocations != 1 {
+				t.Errorf("counted %d allocations; want 1", counter.Allocations)
+			}
+		})
+	}
+}
+
+// BenchmarkPancakeSort benchmarks PancakeSort on random and reverse sorted inputs
+func BenchmarkPancakeSort(b *testing.B) {
+	generator := pkg.NewRandomGeneratorWithSeed(42)
+
+	for _, size := range []int{100, 1000, 5000} {
+		inputs := map[string][]int{
+			"Random":  generator.GenerateIntSliceDefault(size),
+			"Reverse": generator.GenerateReverseSortedSlice(size, 1, 1000),
+		}
+
+		for name, input := range inputs {
+			b.Run(fmt.Sprintf("%s/%d", name, size), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					PancakeSort(input)
+				}
+			})
+		}
+	}
+}
+
+// FuzzPancakeSort checks the sort properties of every Pancake Sort variant on fuzzed inputs
+func FuzzPancakeSort(f *testing.F) {
+	for _, tc := range pancakeSortTestCases {
+		f.Add(pkg.BytesFromInts(tc.input))
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		input := pkg.IntsFromBytes(data)
+		checks := map[string]error{
+			"PancakeSort":        pkg.CheckSort(PancakeSort, input),
+			"PancakeSortOrdered": pkg.CheckSort(PancakeSortOrdered[int], input),
+			"PancakeSortInPlace": pkg.CheckSortInPlace(PancakeSortInPlace, input),
+			"NewSorter":          pkg.CheckSorter(NewSorter(pkg.NewOperationCounter()), input),
+		}
+		for name, err := range checks {
+			if err != nil {
+				t.Errorf("%s: %v", name, err)
+			}
+		}
+	})
+}
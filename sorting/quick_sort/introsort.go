@@ -0,0 +1,178 @@
+package quick_sort
+
+import (
+	"cmp"
+	"math/bits"
+	"slices"
+
+	"github.com/JoaoVitor615/algorithms-in-go/pkg"
+)
+
+// insertionSortCutoff is the range size below which IntroSort finishes with insertion sort
+const insertionSortCutoff = 16
+
+// IntroSort sorts an array using introspective sort
+// It runs QuickSort with median-of-three pivots, switches to heap sort on ranges that
+// recurse deeper than 2·log2(n) and to insertion sort on ranges smaller than 16 elements
+// Time Complexity: O(n log n) in every case
+// Space Complexity: O(log n), recursing only into the smaller partition
+func IntroSort(arr []int) []int {
+	return IntroSortFunc(arr, cmp.Compare[int])
+}
+
+// IntroSortInstrumented sorts an array like IntroSort and records the comparisons,
+// swaps, writes, recursion depth and allocations it performs in counter
+func IntroSortInstrumented(arr []int, counter *pkg.OperationCounter) []int {
+	if len(arr) <= 1 {
+		return slices.Clone(arr)
+	}
+
+	result := make([]int, len(arr))
+	copy(result, arr)
+	counter.Allocate(len(result))
+
+	introSort(result, pkg.CountComparisons(counter, cmp.Compare[int]), counter)
+	return result
+}
+
+// IntroSortInPlace sorts an array in-place using introspective sort
+func IntroSortInPlace(arr []int) {
+	IntroSortInPlaceFunc(arr, cmp.Compare[int])
+}
+
+// NewIntroSorter returns a pkg.Sorter that runs IntroSort and records the operations it performs in counter
+// counter may be nil when the caller does not need operation counts
+func NewIntroSorter(counter *pkg.OperationCounter) pkg.Sorter {
+	return pkg.SorterFunc(func(arr []int) {
+		if len(arr) <= 1 {
+			return
+		}
+		introSort(arr, pkg.CountComparisons(counter, cmp.Compare[int]), counter)
+	})
+}
+
+// IntroSortOrdered sorts a slice of any ordered type using introspective sort
+// It returns a sorted copy and leaves the original slice untouched
+func IntroSortOrdered[T cmp.Ordered](arr []T) []T {
+	return IntroSortFunc(arr, cmp.Compare[T])
+}
+
+// IntroSortFunc sorts a slice of any type using introspective sort and a comparator function
+func IntroSortFunc[T any](arr []T, compare func(a, b T) int) []T {
+	if len(arr) <= 1 {
+		return slices.Clone(arr)
+	}
+
+	result := make([]T, len(arr))
+	copy(result, arr)
+
+	introSort(result, compare, nil)
+	return result
+}
+
+// IntroSortInPlaceFunc sorts a slice in-place using introspective sort and a comparator function
+func IntroSortInPlaceFunc[T any](arr []T, compare func(a, b T) int) {
+	introSort(arr, compare, nil)
+}
+
+// introSort sorts the whole slice with a depth limit of 2·floor(log2(n))
+// counter may be nil when the caller does not need operation counts
+func introSort[T any](arr []T, compare func(a, b T) int, counter *pkg.OperationCounter) {
+	if len(arr) <= 1 {
+		return
+	}
+
+	depthLimit := 2 * (bits.Len(uint(len(arr))) - 1)
+	introSortHelper(arr, 0, len(arr)-1, depthLimit, compare, counter)
+}
+
+// introSortHelper sorts arr[low..high], recursing into the smaller partition and looping on the larger
+// so the stack never holds more than log2(n) frames
+func introSortHelper[T any](arr []T, low, high, depthLimit int, compare func(a, b T) int, counter *pkg.OperationCounter) {
+	counter.Enter()
+	defer counter.Exit()
+
+	for high-low+1 > insertionSortCutoff {
+		// Too many unbalanced partitions, fall back to the guaranteed O(n log n) heap sort
+		if depthLimit == 0 {
+			heapSortRange(arr[low:high+1], compare, counter)
+			return
+		}
+		depthLimit--
+
+		pivotIndex := medianToMiddle(arr, low, high, compare, counter)
+		split := hoarePartition(arr, low, high, pivotIndex, compare, counter)
+
+		if split-low < high-split {
+			introSortHelper(arr, low, split, depthLimit, compare, counter)
+			low = split + 1
+		} else {
+			introSortHelper(arr, split+1, high, depthLimit, compare, counter)
+			high = split
+		}
+	}
+
+	insertionSortRange(arr, low, high, compare, counter)
+}
+
+// medianToMiddle moves the median of the first, middle and last elements of arr[low..high] to the
+// middle and returns its index, ready for hoarePartition
+// Sorted and reverse-sorted ranges already hold their median there, so their order is not disturbed
+func medianToMiddle[T any](arr []T, low, high int, compare func(a, b T) int, counter *pkg.OperationCounter) int {
+	mid := low + (high-low)/2
+	swap(arr, mid, medianOfThree(arr, low, mid, high, compare), counter)
+	return mid
+}
+
+// heapSortRange sorts arr in-place with a max-heap
+func heapSortRange[T any](arr []T, compare func(a, b T) int, counter *pkg.OperationCounter) {
+	n := len(arr)
+	for i := n/2 - 1; i >= 0; i-- {
+		siftDown(arr, i, n, compare, counter)
+	}
+
+	for end := n - 1; end > 0; end-- {
+		swap(arr, 0, end, counter)
+		siftDown(arr, 0, end, compare, counter)
+	}
+}
+
+// siftDown moves the element at root down until both children are smaller or equal
+// Only the first n elements of the array belong to the heap
+func siftDown[T any](arr []T, root, n int, compare func(a, b T) int, counter *pkg.OperationCounter) {
+	for {
+		largest := root
+		left := 2*root + 1
+		right := left + 1
+
+		if left < n && compare(arr[left], arr[largest]) > 0 {
+			largest = left
+		}
+		if right < n && compare(arr[right], arr[largest]) > 0 {
+			largest = right
+		}
+
+		if largest == root {
+			return
+		}
+
+		swap(arr, root, largest, counter)
+		root = largest
+	}
+}
+
+// insertionSortRange sorts arr[low..high] in-place by insertion
+func insertionSortRange[T any](arr []T, low, high int, compare func(a, b T) int, counter *pkg.OperationCounter) {
+	for i := low + 1; i <= high; i++ {
+		key := arr[i]
+		j := i - 1
+
+		for j >= low && compare(arr[j], key) > 0 {
+			arr[j+1] = arr[j]
+			counter.Write(1)
+			j--
+		}
+		arr[j+1] = key
+		counter.Write(1)
+	}
+}
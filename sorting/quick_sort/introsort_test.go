@@ -0,0 +1,205 @@
+package quick_sort
+
+import (
+	"cmp"
+	"fmt"
+	"math/bits"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/JoaoVitor615/algorithms-in-go/pkg"
+)
+
+// TestIntroSort runs the shared QuickSort table against IntroSort and IntroSortInPlace
+func TestIntroSort(t *testing.T) {
+	for _, tc := range quickSortTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			original := slices.Clone(tc.input)
+
+			if result := IntroSort(tc.input); !reflect.DeepEqual(result, tc.expected) {
+				t.Errorf("IntroSort(%v) = %v; want %v", tc.input, result, tc.expected)
+			}
+			if !reflect.DeepEqual(tc.input, original) {
+				t.Errorf("IntroSort modified its input to %v", tc.input)
+			}
+
+			input := slices.Clone(tc.input)
+			IntroSortInPlace(input)
+			if !reflect.DeepEqual(input, tc.expected) {
+				t.Errorf("IntroSortInPlace modified array to %v; want %v", input, tc.expected)
+			}
+		})
+	}
+}
+
+// TestIntroSortDistributions checks IntroSort against slices.Sort on every input distribution
+func TestIntroSortDistributions(t *testing.T) {
+	generator := pkg.NewRandomGeneratorWithSeed(42)
+
+	for _, distribution := range pkg.Distributions() {
+		for _, size := range []int{15, 16, 17, 1000, 10000} {
+			input := generator.GenerateDistribution(distribution, size)
+			expected := slices.Clone(input)
+			slices.Sort(expected)
+
+			t.Run(fmt.Sprintf("%s/%d", distribution, size), func(t *testing.T) {
+				if result := IntroSort(input); !reflect.DeepEqual(result, expected) {
+					t.Errorf("IntroSort on %s input of %d numbers is not sorted", distribution, size)
+				}
+			})
+		}
+	}
+}
+
+// TestIntroSortGeneric tests the generic versions with non-int element types
+func TestIntroSortGeneric(t *testing.T) {
+	words := []string{"pear", "apple", "fig", "apple", "banana"}
+	expected := []string{"apple", "apple", "banana", "fig", "pear"}
+	if result := IntroSortOrdered(words); !reflect.DeepEqual(result, expected) {
+		t.Errorf("IntroSortOrdered(%v) = %v; want %v", words, result, expected)
+	}
+
+	floats := []float64{3.5, -1.25, 2, 0, 2}
+	IntroSortInPlaceFunc(floats, func(a, b float64) int { return cmp.Compare(b, a) })
+	if want := []float64{3.5, 2, 2, 0, -1.25}; !reflect.DeepEqual(floats, want) {
+		t.Errorf("IntroSortInPlaceFunc descending = %v; want %v", floats, want)
+	}
+}
+
+// TestIntroSortStackDepth checks that the recursion stays within log2(n) frames on the
+// sorted input that drives the classic QuickSort recursion to depth n
+func TestIntroSortStackDepth(t *testing.T) {
+	const n = 1_000_000
+
+	sorted := make([]int, n)
+	reversed := make([]int, n)
+	for i := range sorted {
+		sorted[i] = i
+		reversed[i] = n - i
+	}
+
+	inputs := []struct {
+		name  string
+		input []int
+	}{
+		{"sorted", sorted},
+		{"reverse sorted", reversed},
+		{"all equal", make([]int, n)},
+		{"median-of-3 killer", pkg.NewRandomGeneratorWithSeed(42).GenerateMedianOfThreeKiller(n)},
+	}
+
+	for _, in := range inputs {
+		t.Run(in.name, func(t *testing.T) {
+			counter := pkg.NewOperationCounter()
+			result := IntroSortInstrumented(in.input, counter)
+
+			if !pkg.IsSortedSlice(result) {
+				t.Fatalf("result is not sorted")
+			}
+			if limit := bits.Len(n); counter.MaxDepth > limit {
+				t.Errorf("reached recursion depth %d; want at most %d", counter.MaxDepth, limit)
+			}
+		})
+	}
+}
+
+// TestIntroSortComparisons checks that sorted and reverse-sorted inputs, the easiest ones for a
+// median-of-three pivot, cost no more than n log2 n comparisons and no more than a random input
+func TestIntroSortComparisons(t *testing.T) {
+	const n = 100_000
+	limit := int64(n * (bits.Len(n) - 1))
+
+	random := pkg.NewOperationCounter()
+	IntroSortInstrumented(pkg.NewRandomGeneratorWithSeed(42).GenerateDistribution(pkg.Uniform, n), random)
+
+	sorted := make([]int, n)
+	reversed := make([]int, n)
+	for i := range sorted {
+		sorted[i] = i
+		reversed[i] = n - i
+	}
+
+	inputs := []struct {
+		name  string
+		input []int
+	}{
+		{"sorted", sorted},
+		{"reverse sorted", reversed},
+	}
+
+	for _, in := range inputs {
+		t.Run(in.name, func(t *testing.T) {
+			counter := pkg.NewOperationCounter()
+			IntroSortInstrumented(in.input, counter)
+
+			if counter.Comparisons > limit || counter.Comparisons > random.Comparisons {
+				t.Errorf("%d comparisons; want at most %d and no more than the %d of a random input",
+					counter.Comparisons, limit, random.Comparisons)
+			}
+		})
+	}
+}
+
+// TestIntroSortHeapFallback forces the heap sort fallback by starting with no depth budget
+func TestIntroSortHeapFallback(t *testing.T) {
+	input := pkg.NewRandomGeneratorWithSeed(7).GenerateIntSliceDefault(500)
+	expected := slices.Clone(input)
+	slices.Sort(expected)
+
+	counter := pkg.NewOperationCounter()
+	introSortHelper(input, 0, len(input)-1, 0, cmp.Compare[int], counter)
+
+	if !reflect.DeepEqual(input, expected) {
+		t.Errorf("heap sort fallback did not sort the input")
+	}
+	// Heap sort only swaps, insertion sort would have written single elements
+	if counter.Writes != 2*counter.Swaps {
+		t.Errorf("counted %d writes for %d swaps; want only heap sort swaps", counter.Writes, counter.Swaps)
+	}
+	if counter.MaxDepth != 1 {
+		t.Errorf("reached recursion depth %d; want 1", counter.MaxDepth)
+	}
+}
+
+// BenchmarkIntroSort compares IntroSort with QuickSort on random and sorted inputs
+func BenchmarkIntroSort(b *testing.B) {
+	generator := pkg.NewRandomGeneratorWithSeed(42)
+
+	for _, distribution := range []pkg.Distribution{pkg.Uniform, pkg.Sorted} {
+		data := generator.GenerateDistribution(distribution, 10000)
+
+		b.Run(fmt.Sprintf("IntroSort/%s", distribution), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				IntroSort(data)
+			}
+		})
+		b.Run(fmt.Sprintf("QuickSort/%s", distribution), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				QuickSort(data)
+			}
+		})
+	}
+}
+
+// FuzzIntroSort checks the sort properties of every IntroSort variant on fuzzed inputs
+func FuzzIntroSort(f *testing.F) {
+	for _, tc := range quickSortTestCases {
+		f.Add(pkg.BytesFromInts(tc.input))
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		input := pkg.IntsFromBytes(data)
+		checks := map[string]error{
+			"IntroSort":        pkg.CheckSort(IntroSort, input),
+			"IntroSortOrdered": pkg.CheckSort(IntroSortOrdered[int], input),
+			"IntroSortInPlace": pkg.CheckSortInPlace(IntroSortInPlace, input),
+			"NewIntroSorter":   pkg.CheckSorter(NewIntroSorter(pkg.NewOperationCounter()), input),
+		}
+		for name, err := range checks {
+			if err != nil {
+				t.Errorf("%s: %v", name, err)
+			}
+		}
+	})
+}
@@ -0,0 +1,91 @@
+package quick_sort
+
+import (
+	"cmp"
+	"math/bits"
+	"slices"
+	"sync"
+
+	"github.com/JoaoVitor615/algorithms-in-go/pkg"
+)
+
+// QuickSortParallel sorts an array like IntroSort, sorting the smaller side of partitions
+// above options.Threshold on separate goroutines.
+// At most options.Workers goroutines sort at the same time; unset options use pkg.DefaultParallelOptions.
+func QuickSortParallel(arr []int, options pkg.ParallelOptions) []int {
+	return QuickSortParallelFunc(arr, options, cmp.Compare[int])
+}
+
+// QuickSortParallelFunc sorts a slice like QuickSortParallel using a comparator function
+func QuickSortParallelFunc[T any](arr []T, options pkg.ParallelOptions, compare func(a, b T) int) []T {
+	if len(arr) <= 1 {
+		return slices.Clone(arr)
+	}
+
+	result := make([]T, len(arr))
+	copy(result, arr)
+
+	QuickSortParallelInPlaceFunc(result, options, compare)
+	return result
+}
+
+// QuickSortParallelInPlaceFunc sorts a slice in-place like QuickSortParallel using a comparator function
+func QuickSortParallelInPlaceFunc[T any](arr []T, options pkg.ParallelOptions, compare func(a, b T) int) {
+	if len(arr) <= 1 {
+		return
+	}
+
+	options = options.Normalize()
+	limiter := pkg.NewWorkerLimiter(options.Workers)
+
+	var wg sync.WaitGroup
+	depthLimit := 2 * (bits.Len(uint(len(arr))) - 1)
+	quickSortParallel(arr, 0, len(arr)-1, depthLimit, compare, options.Threshold, limiter, &wg)
+	wg.Wait()
+}
+
+// NewParallelSorter returns a pkg.Sorter that runs QuickSortParallel with options
+// The parallel sort is not instrumented, as a shared counter would serialize its goroutines
+func NewParallelSorter(options pkg.ParallelOptions) pkg.Sorter {
+	return pkg.SorterFunc(func(arr []int) {
+		QuickSortParallelInPlaceFunc(arr, options, cmp.Compare[int])
+	})
+}
+
+// quickSortParallel partitions arr[low..high] like introSortHelper, handing the smaller side
+// to the limiter when it holds at least threshold elements and looping on the larger one
+// until the range falls below threshold
+func quickSortParallel[T any](arr []T, low, high, depthLimit int, compare func(a, b T) int, threshold int, limiter *pkg.WorkerLimiter, wg *sync.WaitGroup) {
+	for high-low+1 > max(threshold, insertionSortCutoff) {
+		if depthLimit == 0 {
+			heapSortRange(arr[low:high+1], compare, nil)
+			return
+		}
+		depthLimit--
+
+		pivotIndex := medianToMiddle(arr, low, high, compare, nil)
+		split := hoarePartition(arr, low, high, pivotIndex, compare, nil)
+
+		// The partitions are disjoint, so the forked side never touches the range this loop keeps
+		forkLow, forkHigh := low, split
+		if split-low < high-split {
+			low = split + 1
+		} else {
+			forkLow, forkHigh = split+1, high
+			high = split
+		}
+
+		// A side below threshold is not worth a goroutine, so it is sorted right here
+		if forkHigh-forkLow+1 < threshold {
+			introSortHelper(arr, forkLow, forkHigh, depthLimit, compare, nil)
+			continue
+		}
+
+		limit := depthLimit
+		limiter.Go(wg, func() {
+			quickSortParallel(arr, forkLow, forkHigh, limit, compare, threshold, limiter, wg)
+		})
+	}
+
+	introSortHelper(arr, low, high, depthLimit, compare, nil)
+}
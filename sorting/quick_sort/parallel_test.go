@@ -0,0 +1,89 @@
+package quick_sort
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/JoaoVitor615/algorithms-in-go/pkg"
+)
+
+// TestQuickSortParallel runs the shared QuickSort table and every input distribution with several worker settings
+func TestQuickSortParallel(t *testing.T) {
+	optionsList := []pkg.ParallelOptions{
+		{Threshold: 2, Workers: 1},
+		{Threshold: 2, Workers: 2},
+		{Threshold: 2, Workers: 8},
+		{Threshold: 64, Workers: 4},
+		{},
+	}
+	generator := pkg.NewRandomGeneratorWithSeed(42)
+
+	for _, options := range optionsList {
+		t.Run(fmt.Sprintf("threshold_%d/workers_%d", options.Threshold, options.Workers), func(t *testing.T) {
+			for _, tc := range quickSortTestCases {
+				input := slices.Clone(tc.input)
+				if result := QuickSortParallel(input, options); !reflect.DeepEqual(result, tc.expected) {
+					t.Errorf("%s: QuickSortParallel(%v) = %v; want %v", tc.name, tc.input, result, tc.expected)
+				}
+				if !reflect.DeepEqual(input, tc.input) {
+					t.Errorf("%s: QuickSortParallel modified its input to %v", tc.name, input)
+				}
+			}
+
+			for _, distribution := range pkg.Distributions() {
+				input := generator.GenerateDistribution(distribution, 5000)
+				expected := slices.Clone(input)
+				slices.Sort(expected)
+
+				QuickSortParallelInPlaceFunc(input, options, cmp.Compare[int])
+				if !reflect.DeepEqual(input, expected) {
+					t.Errorf("QuickSortParallelInPlaceFunc did not sort the %s input", distribution)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkQuickSortParallel compares the parallel version with IntroSort on a million numbers
+func BenchmarkQuickSortParallel(b *testing.B) {
+	data := pkg.NewRandomGeneratorWithSeed(42).GenerateIntSlice(1_000_000, 1, 1_000_000)
+
+	b.Run("IntroSort", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			IntroSort(data)
+		}
+	})
+	b.Run("Parallel", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			QuickSortParallel(data, pkg.DefaultParallelOptions())
+		}
+	})
+}
+
+// FuzzQuickSortParallel checks the sort properties of parallel Quick Sort on fuzzed inputs,
+// with a threshold small enough to fork on most of them
+func FuzzQuickSortParallel(f *testing.F) {
+	for _, tc := range quickSortTestCases {
+		f.Add(pkg.BytesFromInts(tc.input))
+	}
+	options := pkg.ParallelOptions{Threshold: 8, Workers: 4}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		input := pkg.IntsFromBytes(data)
+		checks := map[string]error{
+			"QuickSortParallel": pkg.CheckSort(func(arr []int) []int { return QuickSortParallel(arr, options) }, input),
+			"QuickSortParallelInPlaceFunc": pkg.CheckSortInPlace(func(arr []int) {
+				QuickSortParallelInPlaceFunc(arr, options, cmp.Compare[int])
+			}, input),
+			"NewParallelSorter": pkg.CheckSorter(NewParallelSorter(options), input),
+		}
+		for name, err := range checks {
+			if err != nil {
+				t.Errorf("%s: %v", name, err)
+			}
+		}
+	})
+}
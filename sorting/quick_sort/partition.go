@@ -0,0 +1,257 @@
+package quick_sort
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/JoaoVitor615/algorithms-in-go/pkg"
+)
+
+// PartitionScheme selects how QuickSort splits a range around its pivot
+type PartitionScheme int
+
+const (
+	LomutoPartition    PartitionScheme = iota // single pivot, elements equal to it all go to the left side
+	ThreeWayPartition                         // Dijkstra's Dutch national flag: smaller, equal and greater runs
+	DualPivotPartition                        // Yaroslavskiy's two pivots splitting the range in three parts
+)
+
+// partitionSchemeNames holds the display name of each PartitionScheme, in declaration order
+var partitionSchemeNames = []string{"Lomuto", "ThreeWay", "DualPivot"}
+
+// String returns the name of the scheme, e.g. "ThreeWay"
+func (s PartitionScheme) String() string {
+	if s < 0 || int(s) >= len(partitionSchemeNames) {
+		return fmt.Sprintf("PartitionScheme(%d)", int(s))
+	}
+	return partitionSchemeNames[s]
+}
+
+// QuickSortWithPartition sorts an array using the given partition scheme
+func QuickSortWithPartition(arr []int, scheme PartitionScheme) []int {
+	return QuickSortWithPartitionFunc(arr, scheme, cmp.Compare[int])
+}
+
+// QuickSortWithPartitionFunc sorts a slice using the given partition scheme and comparator function
+func QuickSortWithPartitionFunc[T any](arr []T, scheme PartitionScheme, compare func(a, b T) int) []T {
+	switch scheme {
+	case ThreeWayPartition:
+		return QuickSortThreeWayFunc(arr, compare)
+	case DualPivotPartition:
+		return QuickSortDualPivotFunc(arr, compare)
+	default: // LomutoPartition
+		return QuickSortFunc(arr, compare)
+	}
+}
+
+// QuickSortThreeWay sorts an array using three-way partitioning
+// Elements equal to the pivot are settled in a single pass, so inputs with few distinct
+// values take O(n log k) time for k distinct values instead of degrading to O(n²)
+func QuickSortThreeWay(arr []int) []int {
+	return QuickSortThreeWayFunc(arr, cmp.Compare[int])
+}
+
+// QuickSortThreeWayInstrumented sorts an array like QuickSortThreeWay and records the comparisons,
+// swaps, recursion depth and allocations it performs in counter
+func QuickSortThreeWayInstrumented(arr []int, counter *pkg.OperationCounter) []int {
+	if len(arr) <= 1 {
+		return slices.Clone(arr)
+	}
+
+	result := make([]int, len(arr))
+	copy(result, arr)
+	counter.Allocate(len(result))
+
+	threeWayHelper(result, 0, len(result)-1, pkg.CountComparisons(counter, cmp.Compare[int]), counter)
+	return result
+}
+
+// QuickSortThreeWayFunc sorts a slice using three-way partitioning and a comparator function
+func QuickSortThreeWayFunc[T any](arr []T, compare func(a, b T) int) []T {
+	if len(arr) <= 1 {
+		return slices.Clone(arr)
+	}
+
+	result := make([]T, len(arr))
+	copy(result, arr)
+
+	threeWayHelper(result, 0, len(result)-1, compare, nil)
+	return result
+}
+
+// QuickSortDualPivot sorts an array using Yaroslavskiy's dual-pivot partitioning
+// Two pivots split every range in three parts, which needs fewer swaps than a single pivot on average
+func QuickSortDualPivot(arr []int) []int {
+	return QuickSortDualPivotFunc(arr, cmp.Compare[int])
+}
+
+// QuickSortDualPivotInstrumented sorts an array like QuickSortDualPivot and records the comparisons,
+// swaps, recursion depth and allocations it performs in counter
+func QuickSortDualPivotInstrumented(arr []int, counter *pkg.OperationCounter) []int {
+	if len(arr) <= 1 {
+		return slices.Clone(arr)
+	}
+
+	result := make([]int, len(arr))
+	copy(result, arr)
+	counter.Allocate(len(result))
+
+	dualPivotHelper(result, 0, len(result)-1, pkg.CountComparisons(counter, cmp.Compare[int]), counter)
+	return result
+}
+
+// QuickSortDualPivotFunc sorts a slice using dual-pivot partitioning and a comparator function
+func QuickSortDualPivotFunc[T any](arr []T, compare func(a, b T) int) []T {
+	if len(arr) <= 1 {
+		return slices.Clone(arr)
+	}
+
+	result := make([]T, len(arr))
+	copy(result, arr)
+
+	dualPivotHelper(result, 0, len(result)-1, compare, nil)
+	return result
+}
+
+// threeWayHelper recursively sorts arr[low..high] around its middle element
+func threeWayHelper[T any](arr []T, low, high int, compare func(a, b T) int, counter *pkg.OperationCounter) {
+	counter.Enter()
+	defer counter.Exit()
+
+	if low < high {
+		// The middle element keeps sorted and reverse-sorted inputs balanced
+		swap(arr, low, low+(high-low)/2, counter)
+
+		lt, gt := threeWayPartition(arr, low, high, compare, counter)
+		threeWayHelper(arr, low, lt-1, compare, counter)
+		threeWayHelper(arr, gt+1, high, compare, counter)
+	}
+}
+
+// threeWayPartition performs a three-way partition of arr[low..high] around the pivot at arr[low]
+// It returns the bounds of the run of elements equal to the pivot
+func threeWayPartition[T any](arr []T, low, high int, compare func(a, b T) int, counter *pkg.OperationCounter) (lt, gt int) {
+	pivot := arr[low]
+	lt, gt = low, high
+
+	for i := low + 1; i <= gt; {
+		switch c := compare(arr[i], pivot); {
+		case c < 0:
+			swap(arr, lt, i, counter)
+			lt++
+			i++
+		case c > 0:
+			swap(arr, i, gt, counter)
+			gt--
+		default:
+			i++
+		}
+	}
+
+	return lt, gt
+}
+
+// hoarePartition partitions arr[low..high] around the value at pivotIndex, which must be below high,
+// and returns the last index of the left part: arr[low..split] then holds no element greater than the
+// pivot, arr[split+1..high] none smaller, and low <= split < high
+// Both scans stop on elements equal to the pivot, so duplicates are spread over both parts, and a
+// range that is already in order is scanned without moving a single element
+func hoarePartition[T any](arr []T, low, high, pivotIndex int, compare func(a, b T) int, counter *pkg.OperationCounter) (split int) {
+	pivot := arr[pivotIndex]
+	i, j := low-1, high+1
+
+	for {
+		i++
+		for compare(arr[i], pivot) < 0 {
+			i++
+		}
+		j--
+		for compare(arr[j], pivot) > 0 {
+			j--
+		}
+
+		if i >= j {
+			return j
+		}
+		swap(arr, i, j, counter)
+	}
+}
+
+// dualPivotHelper recursively sorts arr[low..high] around two pivots p <= q
+// After partitioning the range holds elements < p, p, elements between p and q, q, elements > q
+func dualPivotHelper[T any](arr []T, low, high int, compare func(a, b T) int, counter *pkg.OperationCounter) {
+	counter.Enter()
+	defer counter.Exit()
+
+	if low >= high {
+		return
+	}
+
+	// Take the pivots from the tertiles so that sorted inputs split evenly
+	third := (high - low + 1) / 3
+	swap(arr, low, low+third, counter)
+	swap(arr, high, high-third, counter)
+	if compare(arr[low], arr[high]) > 0 {
+		swap(arr, low, high, counter)
+	}
+
+	lt, gt := low+1, high-1
+	for i := lt; i <= gt; i++ {
+		if compare(arr[i], arr[low]) < 0 {
+			swap(arr, i, lt, counter)
+			lt++
+		} else if compare(arr[i], arr[high]) > 0 {
+			for i < gt && compare(arr[gt], arr[high]) > 0 {
+				gt--
+			}
+			swap(arr, i, gt, counter)
+			gt--
+			if compare(arr[i], arr[low]) < 0 {
+				swap(arr, i, lt, counter)
+				lt++
+			}
+		}
+	}
+
+	// Move the pivots to their final positions
+	lt--
+	gt++
+	swap(arr, low, lt, counter)
+	swap(arr, high, gt, counter)
+
+	dualPivotHelper(arr, low, lt-1, compare, counter)
+	// Equal pivots mean every element in between equals them, so the middle part is already sorted
+	if compare(arr[lt], arr[gt]) < 0 {
+		dualPivotHelper(arr, lt+1, gt-1, compare, counter)
+	}
+	dualPivotHelper(arr, gt+1, high, compare, counter)
+}
+
+// swap exchanges two elements, counting the swap only when they are distinct positions
+func swap[T any](arr []T, i, j int, counter *pkg.OperationCounter) {
+	if i != j {
+		arr[i], arr[j] = arr[j], arr[i]
+		counter.Swap()
+	}
+}
+
+// medianOfThree returns whichever of the indexes a, b and c holds the median value
+func medianOfThree[T any](arr []T, a, b, c int, compare func(a, b T) int) int {
+	if compare(arr[a], arr[b]) < 0 {
+		if compare(arr[b], arr[c]) < 0 {
+			return b
+		}
+		if compare(arr[a], arr[c]) < 0 {
+			return c
+		}
+		return a
+	}
+	if compare(arr[a], arr[c]) < 0 {
+		return a
+	}
+	if compare(arr[b], arr[c]) < 0 {
+		return c
+	}
+	return b
+}
@@ -0,0 +1,155 @@
+package quick_sort
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/JoaoVitor615/algorithms-in-go/pkg"
+)
+
+// TestQuickSortWithPartition runs the shared QuickSort table through every partition scheme
+func TestQuickSortWithPartition(t *testing.T) {
+	for _, scheme := range []PartitionScheme{LomutoPartition, ThreeWayPartition, DualPivotPartition} {
+		t.Run(scheme.String(), func(t *testing.T) {
+			for _, tc := range quickSortTestCases {
+				result := QuickSortWithPartition(tc.input, scheme)
+				if !reflect.DeepEqual(result, tc.expected) {
+					t.Errorf("%s: QuickSortWithPartition(%v) = %v; want %v", tc.name, tc.input, result, tc.expected)
+				}
+			}
+		})
+	}
+}
+
+// TestQuickSortPartitionDistributions checks the new schemes against slices.Sort on every input distribution
+func TestQuickSortPartitionDistributions(t *testing.T) {
+	generator := pkg.NewRandomGeneratorWithSeed(42)
+
+	for _, distribution := range pkg.Distributions() {
+		for _, size := range []int{2, 3, 17, 500} {
+			input := generator.GenerateDistribution(distribution, size)
+			expected := slices.Clone(input)
+			slices.Sort(expected)
+
+			t.Run(fmt.Sprintf("%s/%d", distribution, size), func(t *testing.T) {
+				if result := QuickSortThreeWay(input); !reflect.DeepEqual(result, expected) {
+					t.Errorf("QuickSortThreeWay(%v) = %v; want %v", input, result, expected)
+				}
+				if result := QuickSortDualPivot(input); !reflect.DeepEqual(result, expected) {
+					t.Errorf("QuickSortDualPivot(%v) = %v; want %v", input, result, expected)
+				}
+			})
+		}
+	}
+}
+
+// TestQuickSortPartitionGeneric tests the comparator versions with strings and a descending order
+func TestQuickSortPartitionGeneric(t *testing.T) {
+	words := []string{"pear", "apple", "fig", "apple", "banana"}
+	expected := []string{"apple", "apple", "banana", "fig", "pear"}
+
+	if result := QuickSortThreeWayFunc(words, cmp.Compare[string]); !reflect.DeepEqual(result, expected) {
+		t.Errorf("QuickSortThreeWayFunc(%v) = %v; want %v", words, result, expected)
+	}
+	if result := QuickSortDualPivotFunc(words, cmp.Compare[string]); !reflect.DeepEqual(result, expected) {
+		t.Errorf("QuickSortDualPivotFunc(%v) = %v; want %v", words, result, expected)
+	}
+
+	descending := func(a, b int) int { return cmp.Compare(b, a) }
+	input := []int{4, 2, 5, 1, 3, 2}
+	want := []int{5, 4, 3, 2, 2, 1}
+	if result := QuickSortDualPivotFunc(input, descending); !reflect.DeepEqual(result, want) {
+		t.Errorf("QuickSortDualPivotFunc descending(%v) = %v; want %v", input, result, want)
+	}
+}
+
+// TestQuickSortPartitionDuplicates checks that inputs with few distinct values stay near n log n
+// comparisons with the new schemes, while the Lomuto partition degrades to n²
+func TestQuickSortPartitionDuplicates(t *testing.T) {
+	const n = 2000
+	generator := pkg.NewRandomGeneratorWithSeed(42)
+	inputs := map[string][]int{
+		"few unique": generator.GenerateDistribution(pkg.FewUnique, n),
+		"all equal":  generator.GenerateDistribution(pkg.AllEqual, n),
+	}
+
+	// n log2 n is about 22,000 comparisons for n = 2000, n²/2 about 2,000,000
+	limit := int64(4 * n * 11)
+
+	instrumented := map[string]func([]int, *pkg.OperationCounter) []int{
+		"ThreeWay":  QuickSortThreeWayInstrumented,
+		"DualPivot": QuickSortDualPivotInstrumented,
+	}
+
+	for inputName, input := range inputs {
+		for name, sort := range instrumented {
+			t.Run(inputName+"/"+name, func(t *testing.T) {
+				counter := pkg.NewOperationCounter()
+				result := sort(input, counter)
+
+				if !pkg.IsSortedSlice(result) {
+					t.Fatalf("result is not sorted")
+				}
+				if counter.Comparisons > limit {
+					t.Errorf("%d comparisons, want at most %d", counter.Comparisons, limit)
+				}
+				if counter.Writes != 2*counter.Swaps {
+					t.Errorf("counted %d writes for %d swaps; want two writes per swap", counter.Writes, counter.Swaps)
+				}
+			})
+		}
+	}
+
+	t.Run("all equal/Lomuto degrades", func(t *testing.T) {
+		counter := pkg.NewOperationCounter()
+		QuickSortInstrumented(inputs["all equal"], counter)
+
+		if counter.Comparisons <= limit {
+			t.Errorf("%d comparisons, expected the Lomuto partition to exceed %d", counter.Comparisons, limit)
+		}
+	})
+}
+
+// TestPartitionSchemeString tests the names of the partition schemes
+func TestPartitionSchemeString(t *testing.T) {
+	tests := []struct {
+		scheme   PartitionScheme
+		expected string
+	}{
+		{LomutoPartition, "Lomuto"},
+		{ThreeWayPartition, "ThreeWay"},
+		{DualPivotPartition, "DualPivot"},
+		{PartitionScheme(9), "PartitionScheme(9)"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.scheme.String(); got != tt.expected {
+			t.Errorf("PartitionScheme(%d).String() = %q; want %q", int(tt.scheme), got, tt.expected)
+		}
+	}
+}
+
+// BenchmarkQuickSortFewUnique compares the partition schemes on inputs with only 10 distinct values
+func BenchmarkQuickSortFewUnique(b *testing.B) {
+	generator := pkg.NewRandomGeneratorWithSeed(42)
+
+	for _, size := range []int{1000, 10000, 100000} {
+		data := generator.GenerateDistribution(pkg.FewUnique, size)
+
+		for _, scheme := range []PartitionScheme{LomutoPartition, ThreeWayPartition, DualPivotPartition} {
+			// Lomuto is quadratic here and takes seconds per sort at the largest size
+			if scheme == LomutoPartition && size > 10000 {
+				continue
+			}
+
+			b.Run(fmt.Sprintf("%s/size_%d", scheme, size), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					QuickSortWithPartition(data, scheme)
+				}
+			})
+		}
+	}
+}
@@ -1,114 +1,304 @@
 package quick_sort
 
+import (
+	"cmp"
+	"fmt"
+	"math/rand"
+	"slices"
+
+	"github.com/JoaoVitor615/algorithms-in-go/pkg"
+)
+
 // QuickSort sorts an array using the QuickSort algorithm
 // Time Complexity: O(n log n) average, O(n²) worst case
 // Space Complexity: O(log n) average, O(n) worst case
 func QuickSort(arr []int) []int {
+	return QuickSortOrdered(arr)
+}
+
+// QuickSortInstrumented sorts an array like QuickSort and records the comparisons,
+// swaps, recursion depth and allocations it performs in counter
+func QuickSortInstrumented(arr []int, counter *pkg.OperationCounter) []int {
 	if len(arr) <= 1 {
-		return arr
+		return slices.Clone(arr)
+	}
+
+	result := make([]int, len(arr))
+	copy(result, arr)
+	counter.Allocate(len(result))
+
+	quickSortHelper(result, 0, len(result)-1, pkg.CountComparisons(counter, cmp.Compare[int]), nil, counter, nil)
+	return result
+}
+
+// QuickSortTraced sorts an array like QuickSort and records in tracer every recursive call,
+// pivot choice, comparison against the pivot and swap it performs
+func QuickSortTraced(arr []int, tracer *pkg.Tracer) []int {
+	result := slices.Clone(arr)
+	if len(result) > 1 {
+		quickSortHelper(result, 0, len(result)-1, cmp.Compare[int], nil, nil, tracer)
+	}
+	return result
+}
+
+// QuickSortInPlace sorts an array in-place using the QuickSort algorithm
+func QuickSortInPlace(arr []int) {
+	QuickSortInPlaceOrdered(arr)
+}
+
+// QuickSortWithCallback sorts an array and calls a callback function after each swap
+// This is useful for visualization or educational purposes
+func QuickSortWithCallback(arr []int, callback func([]int, int, int)) []int {
+	return QuickSortWithCallbackOrdered(arr, callback)
+}
+
+// NewSorter returns a pkg.Sorter that runs QuickSort and records the operations it performs in counter
+// counter may be nil when the caller does not need operation counts
+func NewSorter(counter *pkg.OperationCounter) pkg.Sorter {
+	return pkg.SorterFunc(func(arr []int) {
+		if len(arr) <= 1 {
+			return
+		}
+		quickSortHelper(arr, 0, len(arr)-1, pkg.CountComparisons(counter, cmp.Compare[int]), nil, counter, nil)
+	})
+}
+
+// QuickSortWithCustomPivot allows choosing different pivot strategies
+type PivotStrategy int
+
+const (
+	LastElement PivotStrategy = iota
+	FirstElement
+	MiddleElement
+	RandomElement // uniformly random element of the range
+	MedianOfThree // median of the first, middle and last elements
+	Ninther       // Tukey's median of three medians of three, median-of-three on small ranges
+)
+
+// pivotStrategyNames holds the display name of each PivotStrategy, in declaration order
+var pivotStrategyNames = []string{"LastElement", "FirstElement", "MiddleElement", "RandomElement", "MedianOfThree", "Ninther"}
+
+// String returns the name of the strategy, e.g. "MedianOfThree"
+func (s PivotStrategy) String() string {
+	if s < 0 || int(s) >= len(pivotStrategyNames) {
+		return fmt.Sprintf("PivotStrategy(%d)", int(s))
+	}
+	return pivotStrategyNames[s]
+}
+
+// nintherThreshold is the smallest range on which Ninther samples nine elements instead of three
+const nintherThreshold = 40
+
+// QuickSortCustom performs QuickSort with custom pivot selection
+func QuickSortCustom(arr []int, strategy PivotStrategy) []int {
+	return QuickSortCustomOrdered(arr, strategy)
+}
+
+// QuickSortCustomInstrumented sorts an array like QuickSortCustomWithRand and records the comparisons,
+// swaps, recursion depth and allocations it performs in counter
+func QuickSortCustomInstrumented(arr []int, strategy PivotStrategy, rng *rand.Rand, counter *pkg.OperationCounter) []int {
+	if len(arr) <= 1 {
+		return slices.Clone(arr)
 	}
 
-	// Make a copy to avoid modifying the original array
 	result := make([]int, len(arr))
 	copy(result, arr)
-	
-	quickSortHelper(result, 0, len(result)-1)
+	counter.Allocate(len(result))
+
+	sorter := customQuickSort[int]{
+		strategy: strategy,
+		compare:  pkg.CountComparisons(counter, cmp.Compare[int]),
+		rng:      rng,
+		counter:  counter,
+	}
+	sorter.sort(result, 0, len(result)-1)
+	return result
+}
+
+// QuickSortOrdered sorts a slice of any ordered type (integers, floats, strings)
+// It returns a sorted copy and leaves the original slice untouched
+func QuickSortOrdered[T cmp.Ordered](arr []T) []T {
+	return QuickSortFunc(arr, cmp.Compare[T])
+}
+
+// QuickSortFunc sorts a slice of any type using a comparator function
+// The comparator must return a negative number when a < b, zero when a == b
+// and a positive number when a > b, matching the contract of cmp.Compare
+func QuickSortFunc[T any](arr []T, compare func(a, b T) int) []T {
+	return QuickSortWithCallbackFunc(arr, compare, nil)
+}
+
+// QuickSortInPlaceOrdered sorts a slice of any ordered type in-place
+func QuickSortInPlaceOrdered[T cmp.Ordered](arr []T) {
+	QuickSortInPlaceFunc(arr, cmp.Compare[T])
+}
+
+// QuickSortInPlaceFunc sorts a slice in-place using a comparator function
+func QuickSortInPlaceFunc[T any](arr []T, compare func(a, b T) int) {
+	if len(arr) <= 1 {
+		return
+	}
+	quickSortHelper(arr, 0, len(arr)-1, compare, nil, nil, nil)
+}
+
+// QuickSortWithCallbackOrdered sorts a slice of any ordered type and calls a callback after each swap
+func QuickSortWithCallbackOrdered[T cmp.Ordered](arr []T, callback func([]T, int, int)) []T {
+	return QuickSortWithCallbackFunc(arr, cmp.Compare[T], callback)
+}
+
+// QuickSortWithCallbackFunc sorts a slice using a comparator function and calls a callback after each swap
+// Swaps of an element with itself leave the slice unchanged and are not reported
+func QuickSortWithCallbackFunc[T any](arr []T, compare func(a, b T) int, callback func([]T, int, int)) []T {
+	if len(arr) <= 1 {
+		return slices.Clone(arr)
+	}
+
+	// Make a copy to avoid modifying the original array
+	result := make([]T, len(arr))
+	copy(result, arr)
+
+	quickSortHelper(result, 0, len(result)-1, compare, callback, nil, nil)
+	return result
+}
+
+// QuickSortCustomOrdered performs QuickSort with custom pivot selection on any ordered type
+func QuickSortCustomOrdered[T cmp.Ordered](arr []T, strategy PivotStrategy) []T {
+	return QuickSortCustomFunc(arr, strategy, cmp.Compare[T])
+}
+
+// QuickSortCustomFunc performs QuickSort with custom pivot selection using a comparator function
+// RandomElement draws from the shared source of math/rand, use QuickSortCustomWithRand for reproducible runs
+func QuickSortCustomFunc[T any](arr []T, strategy PivotStrategy, compare func(a, b T) int) []T {
+	return QuickSortCustomWithRand(arr, strategy, compare, nil)
+}
+
+// QuickSortCustomWithRand performs QuickSort with custom pivot selection, drawing random pivots from rng
+// A nil rng falls back to the shared source of math/rand
+func QuickSortCustomWithRand[T any](arr []T, strategy PivotStrategy, compare func(a, b T) int, rng *rand.Rand) []T {
+	if len(arr) <= 1 {
+		return slices.Clone(arr)
+	}
+
+	result := make([]T, len(arr))
+	copy(result, arr)
+
+	sorter := customQuickSort[T]{strategy: strategy, compare: compare, rng: rng}
+	sorter.sort(result, 0, len(result)-1)
 	return result
 }
 
 // quickSortHelper performs the recursive QuickSort on the array slice
-func quickSortHelper(arr []int, low, high int) {
+// callback, counter and tracer may be nil when the caller does not need them
+func quickSortHelper[T any](arr []T, low, high int, compare func(a, b T) int, callback func([]T, int, int), counter *pkg.OperationCounter, tracer *pkg.Tracer) {
+	counter.Enter()
+	defer counter.Exit()
+	tracer.Enter(low, high)
+	defer tracer.Exit(low, high)
+
 	if low < high {
 		// Partition the array and get the pivot index
-		pivotIndex := partition(arr, low, high)
-		
+		pivotIndex := partition(arr, low, high, compare, callback, counter, tracer)
+
 		// Recursively sort elements before and after partition
-		quickSortHelper(arr, low, pivotIndex-1)
-		quickSortHelper(arr, pivotIndex+1, high)
+		quickSortHelper(arr, low, pivotIndex-1, compare, callback, counter, tracer)
+		quickSortHelper(arr, pivotIndex+1, high, compare, callback, counter, tracer)
 	}
 }
 
 // partition rearranges the array so that elements smaller than pivot
 // are on the left, and elements greater than pivot are on the right
-func partition(arr []int, low, high int) int {
+// Swaps of an element with itself are traced too, so the trace matches the operation counts
+func partition[T any](arr []T, low, high int, compare func(a, b T) int, callback func([]T, int, int), counter *pkg.OperationCounter, tracer *pkg.Tracer) int {
 	// Choose the rightmost element as pivot
 	pivot := arr[high]
-	
+	tracer.Pivot(high)
+
 	// Index of smaller element (indicates right position of pivot)
 	i := low - 1
-	
+
 	for j := low; j < high; j++ {
 		// If current element is smaller than or equal to pivot
-		if arr[j] <= pivot {
+		tracer.Compare(j, high)
+		if compare(arr[j], pivot) <= 0 {
 			i++
 			arr[i], arr[j] = arr[j], arr[i] // Swap elements
+			counter.Swap()
+			tracer.Swap(i, j)
+			if callback != nil && i != j {
+				callback(arr, i, j)
+			}
 		}
 	}
-	
+
 	// Swap the pivot element with the element at i+1
 	arr[i+1], arr[high] = arr[high], arr[i+1]
+	counter.Swap()
+	tracer.Swap(i+1, high)
+	if callback != nil && i+1 != high {
+		callback(arr, i+1, high)
+	}
 	return i + 1
 }
 
-// QuickSortInPlace sorts an array in-place using the QuickSort algorithm
-func QuickSortInPlace(arr []int) {
-	if len(arr) <= 1 {
-		return
-	}
-	quickSortHelper(arr, 0, len(arr)-1)
+// customQuickSort holds the settings of a QuickSort with a custom pivot strategy
+// counter may be nil when the caller does not need operation counts
+type customQuickSort[T any] struct {
+	strategy PivotStrategy
+	compare  func(a, b T) int
+	rng      *rand.Rand
+	counter  *pkg.OperationCounter
 }
 
-// QuickSortWithCustomPivot allows choosing different pivot strategies
-type PivotStrategy int
+// sort recursively sorts arr[low..high] around the pivot chosen by the strategy
+func (s customQuickSort[T]) sort(arr []T, low, high int) {
+	s.counter.Enter()
+	defer s.counter.Exit()
 
-const (
-	LastElement PivotStrategy = iota
-	FirstElement
-	MiddleElement
-	RandomElement
-)
+	if low < high {
+		// hoarePartition needs the pivot below high; the middle is where sorted and
+		// reverse-sorted ranges already hold their median, so it is moved there
+		mid := low + (high-low)/2
+		swap(arr, mid, s.choosePivot(arr, low, high), s.counter)
 
-// QuickSortCustom performs QuickSort with custom pivot selection
-func QuickSortCustom(arr []int, strategy PivotStrategy) []int {
-	if len(arr) <= 1 {
-		return arr
+		// Both sides stop on elements equal to the pivot, so inputs with many
+		// duplicates are split evenly instead of degrading to O(n²)
+		split := hoarePartition(arr, low, high, mid, s.compare, s.counter)
+		s.sort(arr, low, split)
+		s.sort(arr, split+1, high)
 	}
-
-	result := make([]int, len(arr))
-	copy(result, arr)
-	
-	quickSortCustomHelper(result, 0, len(result)-1, strategy)
-	return result
 }
 
-func quickSortCustomHelper(arr []int, low, high int, strategy PivotStrategy) {
-	if low < high {
-		// Choose pivot based on strategy
-		pivotIndex := choosePivot(arr, low, high, strategy)
-		
-		// Move chosen pivot to end for partitioning
-		if pivotIndex != high {
-			arr[pivotIndex], arr[high] = arr[high], arr[pivotIndex]
-		}
-		
-		// Partition and recursively sort
-		pi := partition(arr, low, high)
-		quickSortCustomHelper(arr, low, pi-1, strategy)
-		quickSortCustomHelper(arr, pi+1, high, strategy)
-	}
-}
+// choosePivot returns the index of the pivot for arr[low..high]
+func (s customQuickSort[T]) choosePivot(arr []T, low, high int) int {
+	mid := low + (high-low)/2
 
-func choosePivot(arr []int, low, high int, strategy PivotStrategy) int {
-	switch strategy {
+	switch s.strategy {
 	case FirstElement:
 		return low
 	case MiddleElement:
-		return (low + high) / 2
+		return mid
 	case RandomElement:
-		// For simplicity, we'll use middle as "random"
-		// In a real implementation, you'd use rand.Intn()
-		return (low + high) / 2
+		return low + s.intn(high-low+1)
+	case MedianOfThree:
+		return medianOfThree(arr, low, mid, high, s.compare)
+	case Ninther:
+		if high-low+1 < nintherThreshold {
+			return medianOfThree(arr, low, mid, high, s.compare)
+		}
+		step := (high - low + 1) / 8
+		return medianOfThree(arr,
+			medianOfThree(arr, low, low+step, low+2*step, s.compare),
+			medianOfThree(arr, mid-step, mid, mid+step, s.compare),
+			medianOfThree(arr, high-2*step, high-step, high, s.compare), s.compare)
 	default: // LastElement
 		return high
 	}
 }
+
+// intn returns a random index in [0, n) from the injected source, or the shared one when none was given
+func (s customQuickSort[T]) intn(n int) int {
+	if s.rng == nil {
+		return rand.Intn(n)
+	}
+	return s.rng.Intn(n)
+}
@@ -1,73 +1,79 @@
 package quick_sort
 
 import (
+	"cmp"
 	"fmt"
+	"math/bits"
+	"math/rand"
 	"reflect"
+	"slices"
 	"testing"
+
+	"github.com/JoaoVitor615/algorithms-in-go/pkg"
 )
 
+// quickSortTestCases is the shared table used by the int and generic QuickSort tests.
+var quickSortTestCases = []struct {
+	name     string
+	input    []int
+	expected []int
+}{
+	{
+		name:     "Empty array",
+		input:    []int{},
+		expected: []int{},
+	},
+	{
+		name:     "Single element",
+		input:    []int{5},
+		expected: []int{5},
+	},
+	{
+		name:     "Already sorted array",
+		input:    []int{1, 2, 3, 4, 5},
+		expected: []int{1, 2, 3, 4, 5},
+	},
+	{
+		name:     "Reverse sorted array",
+		input:    []int{5, 4, 3, 2, 1},
+		expected: []int{1, 2, 3, 4, 5},
+	},
+	{
+		name:     "Unsorted array with even number of elements",
+		input:    []int{4, 2, 5, 1, 3, 6},
+		expected: []int{1, 2, 3, 4, 5, 6},
+	},
+	{
+		name:     "Unsorted array with odd number of elements",
+		input:    []int{4, 2, 5, 1, 3},
+		expected: []int{1, 2, 3, 4, 5},
+	},
+	{
+		name:     "Array with duplicate elements",
+		input:    []int{4, 2, 5, 1, 3, 2, 4},
+		expected: []int{1, 2, 2, 3, 4, 4, 5},
+	},
+	{
+		name:     "Array with all same elements",
+		input:    []int{3, 3, 3, 3, 3},
+		expected: []int{3, 3, 3, 3, 3},
+	},
+	{
+		name:     "Array with negative numbers",
+		input:    []int{-5, 2, -3, 8, 1, -1},
+		expected: []int{-5, -3, -1, 1, 2, 8},
+	},
+	{
+		name:     "Large random array",
+		input:    []int{64, 34, 25, 12, 22, 11, 90, 88, 76, 50, 42},
+		expected: []int{11, 12, 22, 25, 34, 42, 50, 64, 76, 88, 90},
+	},
+}
+
 // TestQuickSort runs unit tests for the QuickSort function.
 func TestQuickSort(t *testing.T) {
-	// Define a series of test cases.
-	testCases := []struct {
-		name     string
-		input    []int
-		expected []int
-	}{
-		{
-			name:     "Empty array",
-			input:    []int{},
-			expected: []int{},
-		},
-		{
-			name:     "Single element",
-			input:    []int{5},
-			expected: []int{5},
-		},
-		{
-			name:     "Already sorted array",
-			input:    []int{1, 2, 3, 4, 5},
-			expected: []int{1, 2, 3, 4, 5},
-		},
-		{
-			name:     "Reverse sorted array",
-			input:    []int{5, 4, 3, 2, 1},
-			expected: []int{1, 2, 3, 4, 5},
-		},
-		{
-			name:     "Unsorted array with even number of elements",
-			input:    []int{4, 2, 5, 1, 3, 6},
-			expected: []int{1, 2, 3, 4, 5, 6},
-		},
-		{
-			name:     "Unsorted array with odd number of elements",
-			input:    []int{4, 2, 5, 1, 3},
-			expected: []int{1, 2, 3, 4, 5},
-		},
-		{
-			name:     "Array with duplicate elements",
-			input:    []int{4, 2, 5, 1, 3, 2, 4},
-			expected: []int{1, 2, 2, 3, 4, 4, 5},
-		},
-		{
-			name:     "Array with all same elements",
-			input:    []int{3, 3, 3, 3, 3},
-			expected: []int{3, 3, 3, 3, 3},
-		},
-		{
-			name:     "Array with negative numbers",
-			input:    []int{-5, 2, -3, 8, 1, -1},
-			expected: []int{-5, -3, -1, 1, 2, 8},
-		},
-		{
-			name:     "Large random array",
-			input:    []int{64, 34, 25, 12, 22, 11, 90, 88, 76, 50, 42},
-			expected: []int{11, 12, 22, 25, 34, 42, 50, 64, 76, 88, 90},
-		},
-	}
-
 	// Iterate through each test case.
-	for _, tc := range testCases {
+	for _, tc := range quickSortTestCases {
 		// Run the test in a subtest for clear output.
 		t.Run(tc.name, func(t *testing.T) {
 			// Make a copy of input to ensure original isn't modified
@@ -146,22 +152,264 @@ func TestQuickSortCustom(t *testing.T) {
 		FirstElement,
 		MiddleElement,
 		RandomElement,
+		MedianOfThree,
+		Ninther,
 	}
 
-	strategyNames := []string{
-		"LastElement",
-		"FirstElement", 
-		"MiddleElement",
-		"RandomElement",
-	}
-
-	for i, strategy := range strategies {
-		t.Run(strategyNames[i], func(t *testing.T) {
+	for _, strategy := range strategies {
+		t.Run(strategy.String(), func(t *testing.T) {
 			result := QuickSortCustom(input, strategy)
-			
+
 			if !reflect.DeepEqual(result, expected) {
-				t.Errorf("QuickSortCustom with %s strategy: got %v; want %v", 
-					strategyNames[i], result, expected)
+				t.Errorf("QuickSortCustom with %s strategy: got %v; want %v",
+					strategy, result, expected)
+			}
+
+			for _, tc := range quickSortTestCases {
+				if got := QuickSortCustom(tc.input, strategy); !reflect.DeepEqual(got, tc.expected) {
+					t.Errorf("%s: QuickSortCustom(%v) = %v; want %v", tc.name, tc.input, got, tc.expected)
+				}
+			}
+		})
+	}
+}
+
+// TestQuickSortCustomAvoidsQuadratic checks that the robust strategies keep the comparisons and
+// recursion depth of the classic worst-case inputs close to n log n and log n, while the
+// last-element pivot degrades to n²
+func TestQuickSortCustomAvoidsQuadratic(t *testing.T) {
+	const n = 100_000
+	log2n := bits.Len(n) - 1
+
+	sorted := make([]int, n)
+	reversed := make([]int, n)
+	for i := range sorted {
+		sorted[i] = i
+		reversed[i] = n - i
+	}
+
+	inputs := []struct {
+		name  string
+		input []int
+	}{
+		{"distinct reverse sorted", reversed},
+		{"distinct sorted", sorted},
+		{"all equal", make([]int, n)},
+	}
+
+	// n log2 n is about 1,600,000 comparisons for n = 100,000, n²/2 about 5,000,000,000
+	comparisonLimit := int64(2 * n * log2n)
+	depthLimit := 4 * log2n
+
+	for _, in := range inputs {
+		for _, strategy := range []PivotStrategy{MiddleElement, RandomElement, MedianOfThree, Ninther} {
+			t.Run(fmt.Sprintf("%s/%s", in.name, strategy), func(t *testing.T) {
+				counter := &pkg.OperationCounter{}
+				result := QuickSortCustomInstrumented(in.input, strategy, rand.New(rand.NewSource(1)), counter)
+
+				if !pkg.IsSortedSlice(result) {
+					t.Fatalf("result is not sorted")
+				}
+				if counter.Comparisons > comparisonLimit || counter.MaxDepth > depthLimit {
+					t.Errorf("%d comparisons at depth %d, want at most %d at depth %d",
+						counter.Comparisons, counter.MaxDepth, comparisonLimit, depthLimit)
+				}
+			})
+		}
+	}
+
+	// A smaller input keeps the quadratic case fast
+	t.Run("distinct reverse sorted/LastElement degrades", func(t *testing.T) {
+		const small = 2000
+		counter := &pkg.OperationCounter{}
+		QuickSortCustomInstrumented(reversed[n-small:], LastElement, nil, counter)
+
+		if limit := int64(2 * small * 11); counter.Comparisons <= limit {
+			t.Errorf("%d comparisons, expected the last-element pivot to exceed %d", counter.Comparisons, limit)
+		}
+	})
+}
+
+// TestQuickSortCustomWithRandReproducible checks that a seeded source makes random pivots repeatable
+func TestQuickSortCustomWithRandReproducible(t *testing.T) {
+	input := pkg.NewRandomGeneratorWithSeed(7).GenerateIntSliceDefault(1000)
+
+	first := &pkg.OperationCounter{}
+	second := &pkg.OperationCounter{}
+	QuickSortCustomInstrumented(input, RandomElement, rand.New(rand.NewSource(99)), first)
+	QuickSortCustomInstrumented(input, RandomElement, rand.New(rand.NewSource(99)), second)
+
+	if *first != *second {
+		t.Errorf("same seed gave different operation counts: %+v and %+v", *first, *second)
+	}
+
+	result := QuickSortCustomWithRand(input, RandomElement, cmp.Compare[int], rand.New(rand.NewSource(99)))
+	if !pkg.IsSortedSlice(result) {
+		t.Errorf("QuickSortCustomWithRand returned an unsorted slice")
+	}
+}
+
+// TestQuickSortOrdered re-runs the QuickSort table against the generic ordered version
+func TestQuickSortOrdered(t *testing.T) {
+	for _, tc := range quickSortTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			originalInput := make([]int, len(tc.input))
+			copy(originalInput, tc.input)
+
+			result := QuickSortOrdered(tc.input)
+
+			if !reflect.DeepEqual(tc.input, originalInput) {
+				t.Errorf("QuickSortOrdered modified the original input array")
+			}
+
+			if !reflect.DeepEqual(result, tc.expected) {
+				t.Errorf("QuickSortOrdered(%v) = %v; want %v", tc.input, result, tc.expected)
+			}
+		})
+	}
+}
+
+// TestQuickSortFunc re-runs the QuickSort table against the comparator version
+func TestQuickSortFunc(t *testing.T) {
+	for _, tc := range quickSortTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			originalInput := make([]int, len(tc.input))
+			copy(originalInput, tc.input)
+
+			result := QuickSortFunc(tc.input, cmp.Compare[int])
+
+			if !reflect.DeepEqual(tc.input, originalInput) {
+				t.Errorf("QuickSortFunc modified the original input array")
+			}
+
+			if !reflect.DeepEqual(result, tc.expected) {
+				t.Errorf("QuickSortFunc(%v) = %v; want %v", tc.input, result, tc.expected)
+			}
+		})
+	}
+}
+
+// TestQuickSortInPlaceFunc re-runs the QuickSort table against the in-place comparator version
+func TestQuickSortInPlaceFunc(t *testing.T) {
+	for _, tc := range quickSortTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			input := make([]int, len(tc.input))
+			copy(input, tc.input)
+
+			QuickSortInPlaceFunc(input, cmp.Compare[int])
+
+			if !reflect.DeepEqual(input, tc.expected) {
+				t.Errorf("QuickSortInPlaceFunc modified array to %v; want %v", input, tc.expected)
+			}
+		})
+	}
+}
+
+// TestQuickSortGenericTypes tests the generic versions with non-int element types
+func TestQuickSortGenericTypes(t *testing.T) {
+	t.Run("Strings", func(t *testing.T) {
+		input := []string{"pear", "apple", "fig", "banana"}
+		expected := []string{"apple", "banana", "fig", "pear"}
+
+		result := QuickSortOrdered(input)
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("QuickSortOrdered(%v) = %v; want %v", input, result, expected)
+		}
+	})
+
+	t.Run("Floats", func(t *testing.T) {
+		input := []float64{3.5, -1.25, 2, 0, 2}
+		expected := []float64{-1.25, 0, 2, 2, 3.5}
+
+		QuickSortInPlaceOrdered(input)
+		if !reflect.DeepEqual(input, expected) {
+			t.Errorf("QuickSortInPlaceOrdered = %v; want %v", input, expected)
+		}
+	})
+
+	t.Run("Structs by field", func(t *testing.T) {
+		type person struct {
+			name string
+			age  int
+		}
+		input := []person{{"Ana", 31}, {"Bruno", 25}, {"Carla", 40}}
+		expected := []person{{"Bruno", 25}, {"Ana", 31}, {"Carla", 40}}
+
+		result := QuickSortFunc(input, func(a, b person) int {
+			return cmp.Compare(a.age, b.age)
+		})
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("QuickSortFunc(%v) = %v; want %v", input, result, expected)
+		}
+	})
+
+	t.Run("Descending comparator", func(t *testing.T) {
+		input := []int{4, 2, 5, 1, 3}
+		expected := []int{5, 4, 3, 2, 1}
+
+		result := QuickSortCustomFunc(input, MiddleElement, func(a, b int) int {
+			return cmp.Compare(b, a)
+		})
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("QuickSortCustomFunc(%v) = %v; want %v", input, result, expected)
+		}
+	})
+}
+
+// TestQuickSortInstrumented tests the operation counts of the instrumented version
+func TestQuickSortInstrumented(t *testing.T) {
+	// Sorted input is the worst case for the last element pivot:
+	// every partition removes a single element
+	input := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	counter := pkg.NewOperationCounter()
+
+	result := QuickSortInstrumented(input, counter)
+	if !reflect.DeepEqual(result, input) {
+		t.Errorf("QuickSortInstrumented(%v) = %v; want %v", input, result, input)
+	}
+
+	// n(n-1)/2 comparisons and a recursion as deep as the array
+	if counter.Comparisons != 28 {
+		t.Errorf("counted %d comparisons; want 28", counter.Comparisons)
+	}
+	if counter.MaxDepth != len(input) {
+		t.Errorf("reached recursion depth %d; want %d", counter.MaxDepth, len(input))
+	}
+	if counter.Writes != 2*counter.Swaps {
+		t.Errorf("counted %d writes for %d swaps; want two writes per swap", counter.Writes, counter.Swaps)
+	}
+
+	// A nil counter must be accepted and record nothing
+	if result := QuickSortInstrumented(input, nil); !reflect.DeepEqual(result, input) {
+		t.Errorf("QuickSortInstrumented with nil counter = %v; want %v", result, input)
+	}
+}
+
+// TestQuickSortTraced tests that the trace replays to the sorted result and agrees with the operation counts
+func TestQuickSortTraced(t *testing.T) {
+	for _, tc := range quickSortTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			counter := pkg.NewOperationCounter()
+			expected := QuickSortInstrumented(tc.input, counter)
+
+			if result := QuickSortTraced(tc.input, nil); !reflect.DeepEqual(result, expected) {
+				t.Errorf("QuickSortTraced(%v, nil) = %v; want %v", tc.input, result, expected)
+			}
+
+			summary, err := pkg.SummarizeTrace(pkg.RecordTrace("quick", QuickSortTraced, tc.input))
+			if err != nil {
+				t.Fatalf("SummarizeTrace() error = %v", err)
+			}
+			if !reflect.DeepEqual(summary.Result, expected) || !summary.Sorted {
+				t.Errorf("replayed trace ends with %v; want %v", summary.Result, expected)
+			}
+			if summary.Counts[pkg.TraceCompare] != int(counter.Comparisons) || summary.Counts[pkg.TraceSwap] != int(counter.Swaps) {
+				t.Errorf("traced %d comparisons and %d swaps; counted %d and %d",
+					summary.Counts[pkg.TraceCompare], summary.Counts[pkg.TraceSwap], counter.Comparisons, counter.Swaps)
+			}
+			if summary.MaxDepth != counter.MaxDepth || summary.Counts[pkg.TraceEnter] != summary.Counts[pkg.TraceExit] {
+				t.Errorf("traced depth %d with %d enters and %d exits; counted depth %d",
+					summary.MaxDepth, summary.Counts[pkg.TraceEnter], summary.Counts[pkg.TraceExit], counter.MaxDepth)
 			}
 		})
 	}
@@ -195,3 +443,141 @@ func BenchmarkQuickSort(b *testing.B) {
 }
 
 
+
+// TestQuickSortReturnsCopy tests that the copying variants never hand back the caller's slice,
+// even for inputs short enough to need no sorting
+func TestQuickSortReturnsCopy(t *testing.T) {
+	sorts := map[string]func([]int) []int{
+		"QuickSort":  QuickSort,
+		"IntroSort":  IntroSort,
+		"ThreeWay":   QuickSortThreeWay,
+		"DualPivot":  QuickSortDualPivot,
+		"Randomized": func(arr []int) []int { return QuickSortCustom(arr, RandomElement) },
+	}
+
+	for name, sort := range sorts {
+		for _, input := range [][]int{{5}, {2, 1}} {
+			sorted := sort(input)
+			sorted[0] = -1
+			if input[0] == -1 {
+				t.Errorf("%s(%d elements) returned a slice sharing memory with its input", name, len(input))
+			}
+		}
+	}
+}
+
+// TestNewSorter tests that NewSorter sorts like QuickSortInstrumented and counts the same comparisons,
+// without recording the copy that QuickSortInstrumented allocates
+func TestNewSorter(t *testing.T) {
+	input := pkg.NewRandomGeneratorWithSeed(1).GenerateIntSlice(500, 0, 100)
+
+	expected := pkg.NewOperationCounter()
+	sorted := QuickSortInstrumented(input, expected)
+
+	counter := pkg.NewOperationCounter()
+	dst := make([]int, len(input))
+	NewSorter(counter).Sort(dst, input)
+
+	if !reflect.DeepEqual(dst, sorted) {
+		t.Errorf("NewSorter(counter).Sort produced %v; want %v", dst, sorted)
+	}
+	if counter.Comparisons != expected.Comparisons || counter.Swaps != expected.Swaps {
+		t.Errorf("counter = %+v; want the comparisons and swaps of %+v", counter, expected)
+	}
+	if counter.Allocations != 0 {
+		t.Errorf("counter.Allocations = %d; want 0", counter.Allocations)
+	}
+}
+
+// TestQuickSortUnstable tests that the stability harness reports Quick Sort reordering equal keys,
+// while still sorting every record
+func TestQuickSortUnstable(t *testing.T) {
+	cases := pkg.StabilityCases(pkg.NewRandomGeneratorWithSeed(1), 200)
+
+	sorts := map[string]func([]pkg.Record) []pkg.Record{
+		"QuickSortFunc": func(records []pkg.Record) []pkg.Record {
+			return QuickSortFunc(records, pkg.CompareRecords)
+		},
+		"IntroSortFunc": func(records []pkg.Record) []pkg.Record {
+			return IntroSortFunc(records, pkg.CompareRecords)
+		},
+		"QuickSortThreeWayFunc": func(records []pkg.Record) []pkg.Record {
+			return QuickSortThreeWayFunc(records, pkg.CompareRecords)
+		},
+		"QuickSortDualPivotFunc": func(records []pkg.Record) []pkg.Record {
+			return QuickSortDualPivotFunc(records, pkg.CompareRecords)
+		},
+	}
+
+	for name, sort := range sorts {
+		report := pkg.VerifyStability(sort, cases)
+		if !report.Sorted || !report.Complete {
+			t.Errorf("%s: %s; want every record sorted", name, report)
+		}
+		if report.Violations == 0 {
+			t.Errorf("%s: %s; want equal keys reordered", name, report)
+		}
+	}
+}
+
+// FuzzQuickSort checks the sort properties of Quick Sort with every pivot strategy and partition scheme on fuzzed inputs
+func FuzzQuickSort(f *testing.F) {
+	for _, tc := range quickSortTestCases {
+		f.Add(pkg.BytesFromInts(tc.input))
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		input := pkg.IntsFromBytes(data)
+		checks := map[string]error{
+			"QuickSort":          pkg.CheckSort(QuickSort, input),
+			"QuickSortThreeWay":  pkg.CheckSort(QuickSortThreeWay, input),
+			"QuickSortDualPivot": pkg.CheckSort(QuickSortDualPivot, input),
+			"QuickSortInPlace":   pkg.CheckSortInPlace(QuickSortInPlace, input),
+			"NewSorter":          pkg.CheckSorter(NewSorter(pkg.NewOperationCounter()), input),
+		}
+		for _, strategy := range []PivotStrategy{LastElement, FirstElement, MiddleElement, RandomElement, MedianOfThree, Ninther} {
+			checks["QuickSortCustom/"+strategy.String()] = pkg.CheckSort(func(arr []int) []int {
+				return QuickSortCustom(arr, strategy)
+			}, input)
+		}
+		for _, scheme := range []PartitionScheme{LomutoPartition, ThreeWayPartition, DualPivotPartition} {
+			checks["QuickSortWithPartition/"+scheme.String()] = pkg.CheckSort(func(arr []int) []int {
+				return QuickSortWithPartition(arr, scheme)
+			}, input)
+		}
+		for name, err := range checks {
+			if err != nil {
+				t.Errorf("%s: %v", name, err)
+			}
+		}
+	})
+}
+
+// TestQuickSortWithCallback tests that every callback reports the swap that turned the previous state into the current one
+func TestQuickSortWithCallback(t *testing.T) {
+	for _, tc := range quickSortTestCases {
+		previous := slices.Clone(tc.input)
+		result := QuickSortWithCallback(tc.input, func(arr []int, i, j int) {
+			if i == j {
+				t.Errorf("%s: callback reported a swap of index %d with itself", tc.name, i)
+			}
+			previous[i], previous[j] = previous[j], previous[i]
+			if !slices.Equal(previous, arr) {
+				t.Errorf("%s: swapping %d and %d gives %v; callback saw %v", tc.name, i, j, previous, arr)
+				copy(previous, arr)
+			}
+		})
+
+		if !reflect.DeepEqual(result, tc.expected) {
+			t.Errorf("%s: QuickSortWithCallback(%v) = %v; want %v", tc.name, tc.input, result, tc.expected)
+		}
+		if !slices.Equal(previous, result) {
+			t.Errorf("%s: last callback saw %v; result is %v", tc.name, previous, result)
+		}
+	}
+
+	// A nil callback must not panic
+	if result := QuickSortWithCallback([]int{3, 1, 2}, nil); !reflect.DeepEqual(result, []int{1, 2, 3}) {
+		t.Errorf("QuickSortWithCallback with nil callback = %v; want [1 2 3]", result)
+	}
+}
@@ -0,0 +1,56 @@
+package radix_sort
+
+import (
+	"cmp"
+	"math/bits"
+)
+
+// Both radix sorts process keys one byte at a time
+const (
+	digitBits = 8
+	radixSize = 1 << digitBits
+	digitMask = radixSize - 1
+)
+
+// insertionSortCutoff is the bucket size below which MSD Radix Sort switches to Insertion Sort
+const insertionSortCutoff = 32
+
+// radixKey maps an int to an unsigned key with the same order
+// Flipping the sign bit moves negative numbers below the positive ones
+func radixKey(x int) uint64 {
+	return uint64(x) ^ (1 << 63)
+}
+
+// digit returns the byte of key starting at bit shift
+func digit(key uint64, shift int) int {
+	return int(key>>shift) & digitMask
+}
+
+// highestDigitShift returns the shift of the most significant byte in which keys differ,
+// or -1 when every key is equal
+func highestDigitShift[T any](arr []T, key func(T) int) int {
+	first := radixKey(key(arr[0]))
+
+	var diff uint64
+	for _, element := range arr[1:] {
+		diff |= radixKey(key(element)) ^ first
+	}
+	if diff == 0 {
+		return -1
+	}
+
+	top := bits.Len64(diff) - 1
+	return top - top%digitBits
+}
+
+// compareKeys returns a comparator that orders elements by key, used for small buckets
+func compareKeys[T any](key func(T) int) func(a, b T) int {
+	return func(a, b T) int {
+		return cmp.Compare(key(a), key(b))
+	}
+}
+
+// identity is the key of an int sorted by its own value
+func identity(x int) int {
+	return x
+}
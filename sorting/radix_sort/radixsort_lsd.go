@@ -0,0 +1,128 @@
+package radix_sort
+
+import (
+	"slices"
+
+	"github.com/JoaoVitor615/algorithms-in-go/pkg"
+)
+
+// RadixSortLSD sorts an array using least significant digit Radix Sort with 8-bit digits
+// Negative numbers are supported by flipping the sign bit of every key
+// Digits shared by every element are skipped, so small ranges need only a few passes
+// Time Complexity: O(d(n + b)), with d = 8 digits of base b = 256 at most
+// Space Complexity: O(n + b)
+func RadixSortLSD(arr []int) []int {
+	return RadixSortLSDByKey(arr, identity)
+}
+
+// RadixSortLSDInstrumented sorts an array like RadixSortLSD and records the
+// element writes and allocations it performs in counter
+// Radix sort never compares two elements, so no comparisons are recorded
+func RadixSortLSDInstrumented(arr []int, counter *pkg.OperationCounter) []int {
+	if len(arr) <= 1 {
+		return slices.Clone(arr)
+	}
+
+	result := make([]int, len(arr))
+	copy(result, arr)
+	counter.Allocate(len(result))
+
+	lsdRadixSort(result, identity, counter)
+	return result
+}
+
+// RadixSortLSDInPlace sorts an array with LSD Radix Sort and writes the result back into arr
+// It still needs O(n) extra memory for the scatter buffer
+func RadixSortLSDInPlace(arr []int) {
+	RadixSortLSDInPlaceByKey(arr, identity)
+}
+
+// NewLSDSorter returns a pkg.Sorter that runs RadixSortLSD and records the operations it performs in counter
+// counter may be nil when the caller does not need operation counts
+func NewLSDSorter(counter *pkg.OperationCounter) pkg.Sorter {
+	return pkg.SorterFunc(func(arr []int) {
+		if len(arr) <= 1 {
+			return
+		}
+		lsdRadixSort(arr, identity, counter)
+	})
+}
+
+// RadixSortLSDByKey sorts a slice of any type by an integer key
+// It returns a sorted copy and leaves the original slice untouched
+// Elements with equal keys keep their original relative order
+func RadixSortLSDByKey[T any](arr []T, key func(T) int) []T {
+	if len(arr) <= 1 {
+		return slices.Clone(arr)
+	}
+
+	result := make([]T, len(arr))
+	copy(result, arr)
+
+	lsdRadixSort(result, key, nil)
+	return result
+}
+
+// RadixSortLSDInPlaceByKey sorts a slice by an integer key and writes the result back into arr
+func RadixSortLSDInPlaceByKey[T any](arr []T, key func(T) int) {
+	lsdRadixSort(arr, key, nil)
+}
+
+// RadixSortLSDInPlaceByKeyInstrumented sorts a slice like RadixSortLSDInPlaceByKey and records
+// the element writes and allocations it performs in counter
+func RadixSortLSDInPlaceByKeyInstrumented[T any](arr []T, key func(T) int, counter *pkg.OperationCounter) {
+	lsdRadixSort(arr, key, counter)
+}
+
+// lsdRadixSort runs one stable counting pass per byte, from the least significant to the most
+// counter may be nil when the caller does not need operation counts
+func lsdRadixSort[T any](arr []T, key func(T) int, counter *pkg.OperationCounter) {
+	if len(arr) <= 1 {
+		return
+	}
+
+	// Count the digits of every pass at once, the counts do not change between passes
+	var counts [64 / digitBits][radixSize]int
+	for _, element := range arr {
+		k := radixKey(key(element))
+		for pass := range counts {
+			counts[pass][digit(k, pass*digitBits)]++
+		}
+	}
+
+	buffer := make([]T, len(arr))
+	counter.Allocate(len(buffer))
+
+	src, dst := arr, buffer
+	for pass := range counts {
+		shift := pass * digitBits
+		offsets := &counts[pass]
+
+		// Every element has the same digit, the pass would not move anything
+		if offsets[digit(radixKey(key(src[0])), shift)] == len(src) {
+			continue
+		}
+
+		// Turn the counts into the first slot of each digit
+		sum := 0
+		for d, count := range offsets {
+			offsets[d] = sum
+			sum += count
+		}
+
+		for _, element := range src {
+			d := digit(radixKey(key(element)), shift)
+			dst[offsets[d]] = element
+			offsets[d]++
+		}
+		counter.Write(len(src))
+
+		src, dst = dst, src
+	}
+
+	// An odd number of passes leaves the result in the buffer
+	if &src[0] != &arr[0] {
+		copy(arr, src)
+		counter.Write(len(arr))
+	}
+}
@@ -0,0 +1,150 @@
+package radix_sort
+
+import (
+	"slices"
+
+	"github.com/JoaoVitor615/algorithms-in-go/pkg"
+	"github.com/JoaoVitor615/algorithms-in-go/sorting/insertion_sort"
+)
+
+// RadixSortMSD sorts an array using most significant digit Radix Sort with 8-bit digits
+// Each bucket is sorted recursively on the next digit, and buckets of up to 32 elements
+// are finished with Insertion Sort. Negative numbers are supported by flipping the sign bit
+// Time Complexity: O(d(n + b)), with d = 8 digits of base b = 256 at most
+// Space Complexity: O(n + d·b)
+func RadixSortMSD(arr []int) []int {
+	return RadixSortMSDByKey(arr, identity)
+}
+
+// RadixSortMSDInstrumented sorts an array like RadixSortMSD and records the comparisons
+// made by Insertion Sort on small buckets, element writes, recursion depth and allocations in counter
+func RadixSortMSDInstrumented(arr []int, counter *pkg.OperationCounter) []int {
+	if len(arr) <= 1 {
+		return slices.Clone(arr)
+	}
+
+	result := make([]int, len(arr))
+	copy(result, arr)
+	counter.Allocate(len(result))
+
+	sorter := msdSorter[int]{key: identity, compare: pkg.CountComparisons(counter, compareKeys(identity)), counter: counter}
+	sorter.sortAll(result)
+	return result
+}
+
+// RadixSortMSDInPlace sorts an array with MSD Radix Sort and writes the result back into arr
+// It still needs O(n) extra memory for the scatter buffer
+func RadixSortMSDInPlace(arr []int) {
+	RadixSortMSDInPlaceByKey(arr, identity)
+}
+
+// NewMSDSorter returns a pkg.Sorter that runs RadixSortMSD and records the operations it performs in counter
+// counter may be nil when the caller does not need operation counts
+func NewMSDSorter(counter *pkg.OperationCounter) pkg.Sorter {
+	return pkg.SorterFunc(func(arr []int) {
+		if len(arr) <= 1 {
+			return
+		}
+		sorter := msdSorter[int]{key: identity, compare: pkg.CountComparisons(counter, compareKeys(identity)), counter: counter}
+		sorter.sortAll(arr)
+	})
+}
+
+// RadixSortMSDByKey sorts a slice of any type by an integer key
+// It returns a sorted copy and leaves the original slice untouched
+// Elements with equal keys keep their original relative order
+func RadixSortMSDByKey[T any](arr []T, key func(T) int) []T {
+	if len(arr) <= 1 {
+		return slices.Clone(arr)
+	}
+
+	result := make([]T, len(arr))
+	copy(result, arr)
+
+	RadixSortMSDInPlaceByKey(result, key)
+	return result
+}
+
+// RadixSortMSDInPlaceByKey sorts a slice by an integer key and writes the result back into arr
+func RadixSortMSDInPlaceByKey[T any](arr []T, key func(T) int) {
+	sorter := msdSorter[T]{key: key, compare: compareKeys(key)}
+	sorter.sortAll(arr)
+}
+
+// msdSorter holds the key and comparator shared by every level of the MSD recursion
+// counter may be nil when the caller does not need operation counts
+type msdSorter[T any] struct {
+	key     func(T) int
+	compare func(a, b T) int
+	counter *pkg.OperationCounter
+}
+
+// sortAll sorts arr starting from the most significant digit in which the keys differ
+func (s *msdSorter[T]) sortAll(arr []T) {
+	if len(arr) <= 1 {
+		return
+	}
+
+	shift := highestDigitShift(arr, s.key)
+	if shift < 0 {
+		return
+	}
+
+	buffer := make([]T, len(arr))
+	s.counter.Allocate(len(buffer))
+
+	s.sort(arr, buffer, shift)
+}
+
+// sort distributes arr into buckets by the digit at shift, then sorts each bucket on the next digit
+// buffer has the same length as arr and is used to scatter the elements
+func (s *msdSorter[T]) sort(arr, buffer []T, shift int) {
+	if len(arr) <= insertionSortCutoff {
+		insertion_sort.InsertionSortInPlaceWithGapInstrumented(arr, 1, s.compare, s.counter)
+		return
+	}
+
+	s.counter.Enter()
+	defer s.counter.Exit()
+
+	// starts[d] is the first slot of digit d, starts[radixSize] the end of the last bucket
+	var starts [radixSize + 1]int
+	for ; shift >= 0; shift -= digitBits {
+		clear(starts[:])
+		for _, element := range arr {
+			starts[digit(radixKey(s.key(element)), shift)+1]++
+		}
+
+		// Skip digits shared by the whole bucket without moving anything
+		if starts[digit(radixKey(s.key(arr[0])), shift)+1] != len(arr) {
+			break
+		}
+	}
+	if shift < 0 {
+		return
+	}
+
+	for d := 1; d <= radixSize; d++ {
+		starts[d] += starts[d-1]
+	}
+
+	next := starts
+	for _, element := range arr {
+		d := digit(radixKey(s.key(element)), shift)
+		buffer[next[d]] = element
+		next[d]++
+	}
+	copy(arr, buffer)
+	s.counter.Write(2 * len(arr))
+
+	if shift == 0 {
+		return
+	}
+
+	for d := 0; d < radixSize; d++ {
+		low, high := starts[d], starts[d+1]
+		if high-low > 1 {
+			s.sort(arr[low:high], buffer[low:high], shift-digitBits)
+		}
+	}
+}
@@ -0,0 +1,264 @@
+package radix_sort
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/JoaoVitor615/algorithms-in-go/pkg"
+)
+
+// radixSortTestCases is the shared table used by the int and generic RadixSort tests.
+var radixSortTestCases = []struct {
+	name     string
+	input    []int
+	expected []int
+}{
+	{
+		name:     "Empty array",
+		input:    []int{},
+		expected: []int{},
+	},
+	{
+		name:     "Single element",
+		input:    []int{5},
+		expected: []int{5},
+	},
+	{
+		name:     "Already sorted array",
+		input:    []int{1, 2, 3, 4, 5},
+		expected: []int{1, 2, 3, 4, 5},
+	},
+	{
+		name:     "Reverse sorted array",
+		input:    []int{5, 4, 3, 2, 1},
+		expected: []int{1, 2, 3, 4, 5},
+	},
+	{
+		name:     "Unsorted array with even number of elements",
+		input:    []int{4, 2, 5, 1, 3, 6},
+		expected: []int{1, 2, 3, 4, 5, 6},
+	},
+	{
+		name:     "Array with duplicate elements",
+		input:    []int{4, 2, 5, 1, 3, 2, 4},
+		expected: []int{1, 2, 2, 3, 4, 4, 5},
+	},
+	{
+		name:     "Array with all same elements",
+		input:    []int{3, 3, 3, 3, 3},
+		expected: []int{3, 3, 3, 3, 3},
+	},
+	{
+		name:     "Array with negative numbers",
+		input:    []int{-5, 2, -3, 8, 1, -1},
+		expected: []int{-5, -3, -1, 1, 2, 8},
+	},
+	{
+		name:     "Large random array",
+		input:    []int{64, 34, 25, 12, 22, 11, 90, 88, 76, 50, 42},
+		expected: []int{11, 12, 22, 25, 34, 42, 50, 64, 76, 88, 90},
+	},
+}
+
+// radixSorts lists every int variant of both radix sorts
+var radixSorts = []struct {
+	name string
+	sort func([]int) []int
+}{
+	{"RadixSortLSD", RadixSortLSD},
+	{"RadixSortLSDInstrumented", func(arr []int) []int { return RadixSortLSDInstrumented(arr, pkg.NewOperationCounter()) }},
+	{"RadixSortLSDByKey", func(arr []int) []int { return RadixSortLSDByKey(arr, identity) }},
+	{"RadixSortLSDInPlace", func(arr []int) []int {
+		result := slices.Clone(arr)
+		RadixSortLSDInPlace(result)
+		return result
+	}},
+	{"RadixSortMSD", RadixSortMSD},
+	{"RadixSortMSDInstrumented", func(arr []int) []int { return RadixSortMSDInstrumented(arr, pkg.NewOperationCounter()) }},
+	{"RadixSortMSDByKey", func(arr []int) []int { return RadixSortMSDByKey(arr, identity) }},
+	{"RadixSortMSDInPlace", func(arr []int) []int {
+		result := slices.Clone(arr)
+		RadixSortMSDInPlace(result)
+		return result
+	}},
+}
+
+// TestRadixSort runs the shared table against every variant
+func TestRadixSort(t *testing.T) {
+	for _, variant := range radixSorts {
+		for _, tc := range radixSortTestCases {
+			t.Run(variant.name+"/"+tc.name, func(t *testing.T) {
+				input := slices.Clone(tc.input)
+				result := variant.sort(input)
+
+				if !reflect.DeepEqual(result, tc.expected) {
+					t.Errorf("%s(%v) = %v; want %v", variant.name, tc.input, result, tc.expected)
+				}
+				if !reflect.DeepEqual(input, tc.input) && variant.name != "RadixSortLSDInPlace" && variant.name != "RadixSortMSDInPlace" {
+					t.Errorf("%s modified its input: got %v, want %v", variant.name, input, tc.input)
+				}
+			})
+		}
+	}
+}
+
+// TestRadixSortRanges checks both radix sorts against slices.Sort on bounded, negative and full-width values
+func TestRadixSortRanges(t *testing.T) {
+	generator := pkg.NewRandomGeneratorWithSeed(42)
+
+	// Combine two random halves so that every byte of the keys varies
+	high := generator.GenerateIntSlice(5000, math.MinInt32, math.MaxInt32)
+	low := generator.GenerateIntSlice(5000, 0, math.MaxUint32)
+	wide := make([]int, len(high))
+	for i := range wide {
+		wide[i] = high[i]<<32 | low[i]
+	}
+
+	inputs := map[string][]int{
+		"Default range":    generator.GenerateIntSliceDefault(10000),
+		"Negative range":   generator.GenerateIntSlice(10000, -1000, 1000),
+		"Few unique":       generator.GenerateFewUniqueSlice(10000, -5, 5, 3),
+		"Full width":       wide,
+		"Extreme values":   {math.MaxInt, math.MinInt, 0, -1, 1, math.MinInt + 1, math.MaxInt - 1, math.MinInt, math.MaxInt},
+		"Shared top bytes": generator.GenerateIntSlice(10000, 1<<40, 1<<40+255),
+	}
+
+	for name, input := range inputs {
+		for _, variant := range radixSorts {
+			t.Run(name+"/"+variant.name, func(t *testing.T) {
+				expected := slices.Clone(input)
+				slices.Sort(expected)
+
+				if result := variant.sort(input); !reflect.DeepEqual(result, expected) {
+					t.Errorf("%s did not sort %d %s numbers", variant.name, len(input), name)
+				}
+			})
+		}
+	}
+}
+
+// TestRadixSortStability sorts tagged records by key and checks that equal keys keep their order
+func TestRadixSortStability(t *testing.T) {
+	type record struct {
+		key   int
+		index int
+	}
+
+	keys := pkg.NewRandomGeneratorWithSeed(7).GenerateIntSlice(5000, -300, 300)
+	records := make([]record, len(keys))
+	for i, key := range keys {
+		records[i] = record{key, i}
+	}
+
+	expected := slices.Clone(records)
+	slices.SortStableFunc(expected, func(a, b record) int { return cmp.Compare(a.key, b.key) })
+
+	key := func(r record) int { return r.key }
+	if result := RadixSortLSDByKey(records, key); !reflect.DeepEqual(result, expected) {
+		t.Error("RadixSortLSDByKey is not stable")
+	}
+	if result := RadixSortMSDByKey(records, key); !reflect.DeepEqual(result, expected) {
+		t.Error("RadixSortMSDByKey is not stable")
+	}
+}
+
+// TestRadixSortInstrumented tests the operation counts of the instrumented versions
+func TestRadixSortInstrumented(t *testing.T) {
+	descending := make([]int, 64)
+	for i := range descending {
+		descending[i] = len(descending) - i
+	}
+
+	testCases := []struct {
+		name        string
+		sort        func([]int, *pkg.OperationCounter) []int
+		input       []int
+		comparisons int64
+		writes      int64
+		maxDepth    int
+	}{
+		{"LSD skips the digits shared by every element", RadixSortLSDInstrumented, []int{5, 4, 3, 2, 1}, 0, 10, 0},
+		{"LSD runs every pass on mixed signs", RadixSortLSDInstrumented, []int{300, 1, -1}, 0, 24, 0},
+		{"MSD sorts small inputs with Insertion Sort", RadixSortMSDInstrumented, []int{5, 4, 3, 2, 1}, 10, 14, 0},
+		{"MSD scatters larger inputs on the lowest digit only", RadixSortMSDInstrumented, descending, 0, 128, 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			counter := pkg.NewOperationCounter()
+			result := tc.sort(tc.input, counter)
+
+			if !slices.IsSorted(result) {
+				t.Errorf("instrumented sort of %v = %v; want sorted output", tc.input, result)
+			}
+			if counter.Comparisons != tc.comparisons || counter.Writes != tc.writes || counter.MaxDepth != tc.maxDepth {
+				t.Errorf("counted %d comparisons, %d writes and depth %d; want %d, %d and %d",
+					counter.Comparisons, counter.Writes, counter.MaxDepth, tc.comparisons, tc.writes, tc.maxDepth)
+			}
+			if counter.Allocations != 2 {
+				t.Errorf("counted %d allocations; want 2", counter.Allocations)
+			}
+		})
+	}
+}
+
+// BenchmarkRadixSort compares both radix sorts with slices.Sort on the default 1-1000 range and on full-width values
+func BenchmarkRadixSort(b *testing.B) {
+	generator := pkg.NewRandomGeneratorWithSeed(42)
+	sorts := []struct {
+		name string
+		sort func([]int) []int
+	}{
+		{"LSD", RadixSortLSD},
+		{"MSD", RadixSortMSD},
+		{"slices.Sort", func(arr []int) []int {
+			result := slices.Clone(arr)
+			slices.Sort(result)
+			return result
+		}},
+	}
+
+	for _, size := range []int{1000, 100000, 1000000} {
+		inputs := map[string][]int{
+			"Default": generator.GenerateIntSliceDefault(size),
+			"Wide":    generator.GenerateIntSlice(size, math.MinInt32, math.MaxInt32),
+		}
+
+		for name, input := range inputs {
+			for _, s := range sorts {
+				b.Run(fmt.Sprintf("%s/%d/%s", name, size, s.name), func(b *testing.B) {
+					for i := 0; i < b.N; i++ {
+						s.sort(input)
+					}
+				})
+			}
+		}
+	}
+}
+
+// FuzzRadixSort checks the sort properties of every LSD and MSD Radix Sort variant on fuzzed inputs
+func FuzzRadixSort(f *testing.F) {
+	for _, tc := range radixSortTestCases {
+		f.Add(pkg.BytesFromInts(tc.input))
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		input := pkg.IntsFromBytes(data)
+		checks := map[string]error{
+			"NewLSDSorter": pkg.CheckSorter(NewLSDSorter(pkg.NewOperationCounter()), input),
+			"NewMSDSorter": pkg.CheckSorter(NewMSDSorter(pkg.NewOperationCounter()), input),
+		}
+		for _, s := range radixSorts {
+			checks[s.name] = pkg.CheckSort(s.sort, input)
+		}
+		for name, err := range checks {
+			if err != nil {
+				t.Errorf("%s: %v", name, err)
+			}
+		}
+	})
+}
@@ -0,0 +1,457 @@
+package sorting
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/JoaoVitor615/algorithms-in-go/pkg"
+	"github.com/JoaoVitor615/algorithms-in-go/sorting/bubble_sort"
+	"github.com/JoaoVitor615/algorithms-in-go/sorting/bucket_sort"
+	"github.com/JoaoVitor615/algorithms-in-go/sorting/counting_sort"
+	"github.com/JoaoVitor615/algorithms-in-go/sorting/cycle_sort"
+	"github.com/JoaoVitor615/algorithms-in-go/sorting/heap_sort"
+	"github.com/JoaoVitor615/algorithms-in-go/sorting/insertion_sort"
+	"github.com/JoaoVitor615/algorithms-in-go/sorting/merge_sort"
+	"github.com/JoaoVitor615/algorithms-in-go/sorting/pancake_sort"
+	"github.com/JoaoVitor615/algorithms-in-go/sorting/quick_sort"
+	"github.com/JoaoVitor615/algorithms-in-go/sorting/radix_sort"
+	"github.com/JoaoVitor615/algorithms-in-go/sorting/selection_sort"
+	"github.com/JoaoVitor615/algorithms-in-go/sorting/shell_sort"
+	"github.com/JoaoVitor615/algorithms-in-go/sorting/tim_sort"
+)
+
+// ErrUnknownAlgorithm is returned when a lookup does not match any registered algorithm
+var ErrUnknownAlgorithm = errors.New("unknown sorting algorithm")
+
+// ErrVisualizationUnsupported is returned when an algorithm has no SortWithCallback to visualize
+var ErrVisualizationUnsupported = errors.New("step-by-step visualization is not supported")
+
+// ErrTracingUnsupported is returned when an algorithm has no SortTraced to record a trace with
+var ErrTracingUnsupported = errors.New("trace recording is not supported")
+
+// AlgorithmKind tells which data structure an algorithm sorts
+type AlgorithmKind int
+
+const (
+	ArrayAlgorithm AlgorithmKind = iota
+	ListAlgorithm
+)
+
+// String returns a readable name for the kind
+func (k AlgorithmKind) String() string {
+	if k == ListAlgorithm {
+		return "linked list"
+	}
+	return "array"
+}
+
+// Complexity holds the asymptotic costs declared by an algorithm
+type Complexity struct {
+	Best    string
+	Average string
+	Worst   string
+	Space   string
+}
+
+// Algorithm describes a sorting algorithm and how to run it
+type Algorithm struct {
+	ID         string // Short identifier used by lookups, e.g. "quick"
+	Name       string // Display name used by menus, e.g. "Quick Sort"
+	Complexity Complexity
+	Stable     bool
+	InPlace    bool
+	Kind       AlgorithmKind // Data structure the algorithm works on internally
+	Sorter     pkg.Sorter    // Sorts slices, converting them to and from Kind when needed
+
+	// Optional constructor of a sorter that records the operations it performs in counter
+	NewInstrumentedSorter func(counter *pkg.OperationCounter) pkg.Sorter
+
+	// Optional sort that calls callback after every step, used by the step-by-step visualization
+	SortWithCallback func(arr []int, callback pkg.StepCallback) []int
+	StepKind         pkg.StepKind // What the indices passed to callback mean, swaps unless set
+
+	// Optional sort that records every compare, swap, write and recursive call in tracer, used by traces
+	SortTraced func(arr []int, tracer *pkg.Tracer) []int
+
+	// SortRecords sorts tagged records by key through the comparator or key form of the algorithm,
+	// so the stability harness can tell equal keys apart; it may sort its argument in place
+	SortRecords func([]pkg.Record) []pkg.Record
+
+	// Baseline is the ID of the sequential algorithm a parallel one is measured against, empty otherwise
+	Baseline string
+}
+
+// Class returns the complexity class of the declared average case
+// Register rejects notations that pkg.ParseComplexityClass does not recognize, so every
+// registered algorithm has one
+func (a Algorithm) Class() pkg.ComplexityClass {
+	class, _ := pkg.ParseComplexityClass(a.Complexity.Average)
+	return class
+}
+
+// Registry keeps the sorting algorithms available to the use case and terminal layers
+type Registry struct {
+	algorithms []Algorithm
+	byName     map[string]int
+	byID       map[string]int
+}
+
+// NewRegistry creates an empty Registry
+func NewRegistry() *Registry {
+	return &Registry{
+		byName: make(map[string]int),
+		byID:   make(map[string]int),
+	}
+}
+
+// DefaultRegistry creates a Registry with every algorithm implemented in this module
+func DefaultRegistry() *Registry {
+	registry := NewRegistry()
+
+	for _, algorithm := range defaultAlgorithms() {
+		if err := registry.Register(algorithm); err != nil {
+			panic(err)
+		}
+	}
+
+	return registry
+}
+
+// Register adds an algorithm to the registry
+// Names and IDs must be unique, a Sorter is required, the kind and the average complexity
+// must be valid and a baseline must already be registered
+func (r *Registry) Register(algorithm Algorithm) error {
+	if algorithm.ID == "" || algorithm.Name == "" {
+		return errors.New("algorithm must have an ID and a name")
+	}
+	if _, exists := r.byID[algorithm.ID]; exists {
+		return fmt.Errorf("algorithm ID %q is already registered", algorithm.ID)
+	}
+	if _, exists := r.byName[algorithm.Name]; exists {
+		return fmt.Errorf("algorithm name %q is already registered", algorithm.Name)
+	}
+
+	if algorithm.Sorter == nil {
+		return fmt.Errorf("algorithm %q has no Sorter", algorithm.Name)
+	}
+	if algorithm.Kind != ArrayAlgorithm && algorithm.Kind != ListAlgorithm {
+		return fmt.Errorf("algorithm %q has an invalid kind %d", algorithm.Name, algorithm.Kind)
+	}
+	if _, err := pkg.ParseComplexityClass(algorithm.Complexity.Average); err != nil {
+		return fmt.Errorf("algorithm %q has an invalid average complexity: %w", algorithm.Name, err)
+	}
+
+	if algorithm.Baseline != "" {
+		if _, exists := r.byID[algorithm.Baseline]; !exists {
+			return fmt.Errorf("baseline %q of algorithm %q is not registered", algorithm.Baseline, algorithm.Name)
+		}
+	}
+
+	r.algorithms = append(r.algorithms, algorithm)
+	r.byID[algorithm.ID] = len(r.algorithms) - 1
+	r.byName[algorithm.Name] = len(r.algorithms) - 1
+	return nil
+}
+
+// Lookup finds an algorithm by its display name or its ID
+func (r *Registry) Lookup(name string) (Algorithm, error) {
+	if index, ok := r.byName[name]; ok {
+		return r.algorithms[index], nil
+	}
+	if index, ok := r.byID[name]; ok {
+		return r.algorithms[index], nil
+	}
+	return Algorithm{}, fmt.Errorf("%w: %q", ErrUnknownAlgorithm, name)
+}
+
+// All returns the registered algorithms in registration order
+func (r *Registry) All() []Algorithm {
+	algorithms := make([]Algorithm, len(r.algorithms))
+	copy(algorithms, r.algorithms)
+	return algorithms
+}
+
+// defaultAlgorithms lists the algorithms registered by DefaultRegistry, in menu order
+func defaultAlgorithms() []Algorithm {
+	return []Algorithm{
+		{
+			ID:                    "merge",
+			Name:                  "Merge Sort",
+			Complexity:            Complexity{Best: "O(n log n)", Average: "O(n log n)", Worst: "O(n log n)", Space: "O(log n)"},
+			Stable:                true,
+			InPlace:               false,
+			Kind:                  ListAlgorithm,
+			Sorter:                merge_sort.NewSorter(nil),
+			NewInstrumentedSorter: merge_sort.NewSorter,
+			SortWithCallback: func(arr []int, callback pkg.StepCallback) []int {
+				return merge_sort.ListValues(merge_sort.MergeSortWithCallback(merge_sort.NewList(arr), callback))
+			},
+			StepKind: pkg.MergeStep,
+			SortRecords: func(records []pkg.Record) []pkg.Record {
+				return merge_sort.ListValues(merge_sort.MergeSortFunc(merge_sort.NewList(records), pkg.CompareRecords))
+			},
+		},
+		{
+			ID:                    "merge-bu",
+			Name:                  "Bottom-Up Merge Sort",
+			Complexity:            Complexity{Best: "O(n log n)", Average: "O(n log n)", Worst: "O(n log n)", Space: "O(1)"},
+			Stable:                true,
+			InPlace:               true,
+			Kind:                  ListAlgorithm,
+			Sorter:                merge_sort.NewBottomUpSorter(nil),
+			NewInstrumentedSorter: merge_sort.NewBottomUpSorter,
+			SortRecords: func(records []pkg.Record) []pkg.Record {
+				return merge_sort.ListValues(merge_sort.MergeSortBottomUpFunc(merge_sort.NewList(records), pkg.CompareRecords))
+			},
+		},
+		{
+			ID:                    "merge-array",
+			Name:                  "Merge Sort (Array)",
+			Complexity:            Complexity{Best: "O(n log n)", Average: "O(n log n)", Worst: "O(n log n)", Space: "O(n)"},
+			Stable:                true,
+			InPlace:               false,
+			Kind:                  ArrayAlgorithm,
+			Sorter:                merge_sort.NewArraySorter(nil),
+			NewInstrumentedSorter: merge_sort.NewArraySorter,
+			SortWithCallback:      merge_sort.MergeSortArrayWithCallback,
+			StepKind:              pkg.MergeStep,
+			SortTraced:            merge_sort.MergeSortArrayTraced,
+			SortRecords:           inPlaceByComparator(merge_sort.MergeSortArrayInPlaceFunc[pkg.Record]),
+		},
+		{
+			ID:                    "merge-array-bu",
+			Name:                  "Bottom-Up Merge Sort (Array)",
+			Complexity:            Complexity{Best: "O(n log n)", Average: "O(n log n)", Worst: "O(n log n)", Space: "O(n)"},
+			Stable:                true,
+			InPlace:               false,
+			Kind:                  ArrayAlgorithm,
+			Sorter:                merge_sort.NewArrayBottomUpSorter(nil),
+			NewInstrumentedSorter: merge_sort.NewArrayBottomUpSorter,
+			SortRecords:           byComparator(merge_sort.MergeSortArrayBottomUpFunc[pkg.Record]),
+		},
+		{
+			ID:         "merge-array-par",
+			Name:       "Parallel Merge Sort (Array)",
+			Complexity: Complexity{Best: "O(n log n)", Average: "O(n log n)", Worst: "O(n log n)", Space: "O(n)"},
+			Stable:     true,
+			InPlace:    false,
+			Kind:       ArrayAlgorithm,
+			Sorter:     merge_sort.NewArrayParallelSorter(pkg.DefaultParallelOptions()),
+			SortRecords: func(records []pkg.Record) []pkg.Record {
+				return merge_sort.MergeSortArrayParallelFunc(records, pkg.DefaultParallelOptions(), pkg.CompareRecords)
+			},
+			Baseline: "merge-array",
+		},
+		{
+			ID:                    "tim",
+			Name:                  "Tim Sort",
+			Complexity:            Complexity{Best: "O(n)", Average: "O(n log n)", Worst: "O(n log n)", Space: "O(n)"},
+			Stable:                true,
+			InPlace:               false,
+			Kind:                  ArrayAlgorithm,
+			Sorter:                tim_sort.NewSorter(nil),
+			NewInstrumentedSorter: tim_sort.NewSorter,
+			SortRecords:           byComparator(tim_sort.TimSortFunc[pkg.Record]),
+		},
+		{
+			ID:                    "quick",
+			Name:                  "Quick Sort",
+			Complexity:            Complexity{Best: "O(n log n)", Average: "O(n log n)", Worst: "O(n²)", Space: "O(log n)"},
+			Stable:                false,
+			InPlace:               true,
+			Kind:                  ArrayAlgorithm,
+			Sorter:                quick_sort.NewSorter(nil),
+			NewInstrumentedSorter: quick_sort.NewSorter,
+			SortWithCallback:      quick_sort.QuickSortWithCallback,
+			SortTraced:            quick_sort.QuickSortTraced,
+			SortRecords:           byComparator(quick_sort.QuickSortFunc[pkg.Record]),
+		},
+		{
+			ID:                    "intro",
+			Name:                  "Intro Sort",
+			Complexity:            Complexity{Best: "O(n log n)", Average: "O(n log n)", Worst: "O(n log n)", Space: "O(log n)"},
+			Stable:                false,
+			InPlace:               true,
+			Kind:                  ArrayAlgorithm,
+			Sorter:                quick_sort.NewIntroSorter(nil),
+			NewInstrumentedSorter: quick_sort.NewIntroSorter,
+			SortRecords:           byComparator(quick_sort.IntroSortFunc[pkg.Record]),
+		},
+		{
+			ID:         "quick-par",
+			Name:       "Parallel Quick Sort",
+			Complexity: Complexity{Best: "O(n log n)", Average: "O(n log n)", Worst: "O(n log n)", Space: "O(log n)"},
+			Stable:     false,
+			InPlace:    true,
+			Kind:       ArrayAlgorithm,
+			Sorter:     quick_sort.NewParallelSorter(pkg.DefaultParallelOptions()),
+			SortRecords: func(records []pkg.Record) []pkg.Record {
+				return quick_sort.QuickSortParallelFunc(records, pkg.DefaultParallelOptions(), pkg.CompareRecords)
+			},
+			Baseline: "intro",
+		},
+		{
+			ID:                    "bubble",
+			Name:                  "Bubble Sort",
+			Complexity:            Complexity{Best: "O(n)", Average: "O(n²)", Worst: "O(n²)", Space: "O(1)"},
+			Stable:                true,
+			InPlace:               true,
+			Kind:                  ArrayAlgorithm,
+			Sorter:                bubble_sort.NewSorter(nil),
+			NewInstrumentedSorter: bubble_sort.NewSorter,
+			SortWithCallback:      bubble_sort.BubbleSortWithCallback,
+			SortTraced:            bubble_sort.BubbleSortOptimizedTraced,
+			SortRecords:           byComparator(bubble_sort.BubbleSortOptimizedFunc[pkg.Record]),
+		},
+		{
+			ID:                    "heap",
+			Name:                  "Heap Sort",
+			Complexity:            Complexity{Best: "O(n log n)", Average: "O(n log n)", Worst: "O(n log n)", Space: "O(1)"},
+			Stable:                false,
+			InPlace:               true,
+			Kind:                  ArrayAlgorithm,
+			Sorter:                heap_sort.NewSorter(nil),
+			NewInstrumentedSorter: heap_sort.NewSorter,
+			SortWithCallback:      heap_sort.HeapSortWithCallback,
+			SortTraced:            heap_sort.HeapSortTraced,
+			SortRecords:           byComparator(heap_sort.HeapSortFunc[pkg.Record]),
+		},
+		{
+			ID:                    "insertion",
+			Name:                  "Insertion Sort",
+			Complexity:            Complexity{Best: "O(n)", Average: "O(n²)", Worst: "O(n²)", Space: "O(1)"},
+			Stable:                true,
+			InPlace:               true,
+			Kind:                  ArrayAlgorithm,
+			Sorter:                insertion_sort.NewSorter(nil),
+			NewInstrumentedSorter: insertion_sort.NewSorter,
+			SortWithCallback:      insertion_sort.InsertionSortWithCallback,
+			StepKind:              pkg.InsertStep,
+			SortTraced:            insertion_sort.InsertionSortTraced,
+			SortRecords:           byComparator(insertion_sort.InsertionSortFunc[pkg.Record]),
+		},
+		{
+			ID:                    "selection",
+			Name:                  "Selection Sort",
+			Complexity:            Complexity{Best: "O(n²)", Average: "O(n²)", Worst: "O(n²)", Space: "O(1)"},
+			Stable:                false,
+			InPlace:               true,
+			Kind:                  ArrayAlgorithm,
+			Sorter:                selection_sort.NewSorter(nil),
+			NewInstrumentedSorter: selection_sort.NewSorter,
+			SortRecords:           byComparator(selection_sort.SelectionSortFunc[pkg.Record]),
+		},
+		{
+			ID:                    "selection-double",
+			Name:                  "Double Selection Sort",
+			Complexity:            Complexity{Best: "O(n²)", Average: "O(n²)", Worst: "O(n²)", Space: "O(1)"},
+			Stable:                false,
+			InPlace:               true,
+			Kind:                  ArrayAlgorithm,
+			Sorter:                selection_sort.NewDoubleSorter(nil),
+			NewInstrumentedSorter: selection_sort.NewDoubleSorter,
+			SortRecords:           byComparator(selection_sort.DoubleSelectionSortFunc[pkg.Record]),
+		},
+		{
+			ID:                    "cycle",
+			Name:                  "Cycle Sort",
+			Complexity:            Complexity{Best: "O(n²)", Average: "O(n²)", Worst: "O(n²)", Space: "O(1)"},
+			Stable:                false,
+			InPlace:               true,
+			Kind:                  ArrayAlgorithm,
+			Sorter:                cycle_sort.NewSorter(nil),
+			NewInstrumentedSorter: cycle_sort.NewSorter,
+			SortRecords:           byComparator(cycle_sort.CycleSortFunc[pkg.Record]),
+		},
+		{
+			ID:                    "pancake",
+			Name:                  "Pancake Sort",
+			Complexity:            Complexity{Best: "O(n²)", Average: "O(n²)", Worst: "O(n²)", Space: "O(1)"},
+			Stable:                false,
+			InPlace:               true,
+			Kind:                  ArrayAlgorithm,
+			Sorter:                pancake_sort.NewSorter(nil),
+			NewInstrumentedSorter: pancake_sort.NewSorter,
+			SortRecords:           byComparator(pancake_sort.PancakeSortFunc[pkg.Record]),
+		},
+		{
+			ID:                    "shell",
+			Name:                  "Shell Sort",
+			Complexity:            Complexity{Best: "O(n log n)", Average: "O(n^(4/3))", Worst: "O(n^(4/3))", Space: "O(1)"},
+			Stable:                false,
+			InPlace:               true,
+			Kind:                  ArrayAlgorithm,
+			Sorter:                shell_sort.NewSorter(nil),
+			NewInstrumentedSorter: shell_sort.NewSorter,
+			SortRecords:           byComparator(shell_sort.ShellSortFunc[pkg.Record]),
+		},
+		{
+			ID:                    "counting",
+			Name:                  "Counting Sort",
+			Complexity:            Complexity{Best: "O(n + k)", Average: "O(n + k)", Worst: "O(n + k)", Space: "O(n + k)"},
+			Stable:                true,
+			InPlace:               false,
+			Kind:                  ArrayAlgorithm,
+			Sorter:                counting_sort.NewSorter(nil),
+			NewInstrumentedSorter: counting_sort.NewSorter,
+			SortRecords:           byKey(counting_sort.CountingSortByKey[pkg.Record]),
+		},
+		{
+			ID:                    "radix-lsd",
+			Name:                  "LSD Radix Sort",
+			Complexity:            Complexity{Best: "O(d(n + b))", Average: "O(d(n + b))", Worst: "O(d(n + b))", Space: "O(n + b)"},
+			Stable:                true,
+			InPlace:               false,
+			Kind:                  ArrayAlgorithm,
+			Sorter:                radix_sort.NewLSDSorter(nil),
+			NewInstrumentedSorter: radix_sort.NewLSDSorter,
+			SortRecords:           byKey(radix_sort.RadixSortLSDByKey[pkg.Record]),
+		},
+		{
+			ID:                    "radix-msd",
+			Name:                  "MSD Radix Sort",
+			Complexity:            Complexity{Best: "O(d(n + b))", Average: "O(d(n + b))", Worst: "O(d(n + b))", Space: "O(n + d·b)"},
+			Stable:                true,
+			InPlace:               false,
+			Kind:                  ArrayAlgorithm,
+			Sorter:                radix_sort.NewMSDSorter(nil),
+			NewInstrumentedSorter: radix_sort.NewMSDSorter,
+			SortRecords:           byKey(radix_sort.RadixSortMSDByKey[pkg.Record]),
+		},
+		{
+			ID:                    "bucket",
+			Name:                  "Bucket Sort",
+			Complexity:            Complexity{Best: "O(n + k)", Average: "O(n + k)", Worst: "O(n²)", Space: "O(n + k)"},
+			Stable:                true,
+			InPlace:               false,
+			Kind:                  ArrayAlgorithm,
+			Sorter:                bucket_sort.NewSorter(nil),
+			NewInstrumentedSorter: bucket_sort.NewSorter,
+			SortRecords: func(records []pkg.Record) []pkg.Record {
+				return bucket_sort.BucketSortByKey(records, len(records), pkg.RecordKey)
+			},
+		},
+	}
+}
+
+// byComparator adapts the comparator form of an algorithm to Algorithm.SortRecords
+func byComparator(sort func([]pkg.Record, func(a, b pkg.Record) int) []pkg.Record) func([]pkg.Record) []pkg.Record {
+	return func(records []pkg.Record) []pkg.Record {
+		return sort(records, pkg.CompareRecords)
+	}
+}
+
+// inPlaceByComparator adapts the in-place comparator form of an algorithm to Algorithm.SortRecords
+func inPlaceByComparator(sort func([]pkg.Record, func(a, b pkg.Record) int)) func([]pkg.Record) []pkg.Record {
+	return func(records []pkg.Record) []pkg.Record {
+		sort(records, pkg.CompareRecords)
+		return records
+	}
+}
+
+// byKey adapts the integer key form of a non-comparison algorithm to Algorithm.SortRecords
+func byKey(sort func([]pkg.Record, func(pkg.Record) int) []pkg.Record) func([]pkg.Record) []pkg.Record {
+	return func(records []pkg.Record) []pkg.Record {
+		return sort(records, pkg.RecordKey)
+	}
+}
@@ -0,0 +1,229 @@
+package sorting
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/JoaoVitor615/algorithms-in-go/pkg"
+	"github.com/JoaoVitor615/algorithms-in-go/sorting/quick_sort"
+)
+
+// TestRegistryLookup tests lookups by name, by ID and for unknown algorithms
+func TestRegistryLookup(t *testing.T) {
+	registry := DefaultRegistry()
+
+	testCases := []struct {
+		name     string
+		lookup   string
+		expected string
+		wantErr  bool
+	}{
+		{name: "By display name", lookup: "Quick Sort", expected: "quick"},
+		{name: "By ID", lookup: "merge", expected: "merge"},
+		{name: "Unknown name", lookup: "Bogo Sort", wantErr: true},
+		{name: "Empty name", lookup: "", wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			algorithm, err := registry.Lookup(tc.lookup)
+
+			if tc.wantErr {
+				if !errors.Is(err, ErrUnknownAlgorithm) {
+					t.Errorf("Lookup(%q) error = %v; want ErrUnknownAlgorithm", tc.lookup, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Lookup(%q) returned unexpected error: %v", tc.lookup, err)
+			}
+			if algorithm.ID != tc.expected {
+				t.Errorf("Lookup(%q).ID = %q; want %q", tc.lookup, algorithm.ID, tc.expected)
+			}
+		})
+	}
+}
+
+// TestRegistryRegister tests that invalid or duplicate registrations are rejected
+func TestRegistryRegister(t *testing.T) {
+	complexity := Complexity{Average: "O(n log n)"}
+	valid := Algorithm{ID: "quick", Name: "Quick Sort", Complexity: complexity, Kind: ArrayAlgorithm, Sorter: quick_sort.NewSorter(nil)}
+
+	testCases := []struct {
+		name      string
+		algorithm Algorithm
+	}{
+		{name: "Duplicate ID", algorithm: Algorithm{ID: "quick", Name: "Other", Complexity: complexity, Kind: ArrayAlgorithm, Sorter: quick_sort.NewSorter(nil)}},
+		{name: "Duplicate name", algorithm: Algorithm{ID: "other", Name: "Quick Sort", Complexity: complexity, Kind: ArrayAlgorithm, Sorter: quick_sort.NewSorter(nil)}},
+		{name: "Missing ID", algorithm: Algorithm{Name: "Nameless", Complexity: complexity, Kind: ArrayAlgorithm, Sorter: quick_sort.NewSorter(nil)}},
+		{name: "Missing Sorter", algorithm: Algorithm{ID: "a", Name: "A", Complexity: complexity, Kind: ArrayAlgorithm}},
+		{name: "Invalid kind", algorithm: Algorithm{ID: "k", Name: "K", Complexity: complexity, Kind: AlgorithmKind(7), Sorter: quick_sort.NewSorter(nil)}},
+		{name: "Unknown complexity", algorithm: Algorithm{ID: "c", Name: "C", Complexity: Complexity{Average: "O(2^n)"}, Kind: ArrayAlgorithm, Sorter: quick_sort.NewSorter(nil)}},
+		{name: "Unregistered baseline", algorithm: Algorithm{ID: "p", Name: "P", Complexity: complexity, Kind: ArrayAlgorithm, Sorter: quick_sort.NewSorter(nil), Baseline: "intro"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			registry := NewRegistry()
+			if err := registry.Register(valid); err != nil {
+				t.Fatalf("Register(valid) returned unexpected error: %v", err)
+			}
+
+			if err := registry.Register(tc.algorithm); err == nil {
+				t.Errorf("Register(%+v) = nil; want error", tc.algorithm)
+			}
+			if len(registry.All()) != 1 {
+				t.Errorf("registry has %d algorithms after rejected registration; want 1", len(registry.All()))
+			}
+		})
+	}
+}
+
+// TestUseCaseUnknownAlgorithm tests that use cases no longer fall back to a default algorithm
+func TestUseCaseUnknownAlgorithm(t *testing.T) {
+	uc := NewUseCase()
+
+	if _, err := uc.ManualSort("Bogo Sort", []int{3, 1, 2}); !errors.Is(err, ErrUnknownAlgorithm) {
+		t.Errorf("ManualSort with unknown algorithm error = %v; want ErrUnknownAlgorithm", err)
+	}
+	if _, err := uc.RunAllBenchmarks("Bogo Sort"); !errors.Is(err, ErrUnknownAlgorithm) {
+		t.Errorf("RunAllBenchmarks with unknown algorithm error = %v; want ErrUnknownAlgorithm", err)
+	}
+
+	for _, algorithm := range uc.Algorithms() {
+		result, err := uc.ManualSort(algorithm.Name, []int{3, 1, 2})
+		if err != nil || !result.IsSorted {
+			t.Errorf("ManualSort(%q) = %+v, %v; want sorted result", algorithm.Name, result, err)
+		}
+	}
+}
+
+// TestAlgorithmClass tests that each default algorithm maps to its declared average complexity class
+func TestAlgorithmClass(t *testing.T) {
+	registry := DefaultRegistry()
+
+	expected := map[string]pkg.ComplexityClass{
+		"merge":            pkg.Linearithmic,
+		"merge-bu":         pkg.Linearithmic,
+		"merge-array":      pkg.Linearithmic,
+		"merge-array-bu":   pkg.Linearithmic,
+		"tim":              pkg.Linearithmic,
+		"quick":            pkg.Linearithmic,
+		"intro":            pkg.Linearithmic,
+		"heap":             pkg.Linearithmic,
+		"bubble":           pkg.Quadratic,
+		"insertion":        pkg.Quadratic,
+		"selection":        pkg.Quadratic,
+		"selection-double": pkg.Quadratic,
+		"cycle":            pkg.Quadratic,
+		"pancake":          pkg.Quadratic,
+		"shell":            pkg.FourThirds,
+		"counting":         pkg.Linear,
+		"radix-lsd":        pkg.Linear,
+		"radix-msd":        pkg.Linear,
+		"bucket":           pkg.Linear,
+	}
+
+	for id, class := range expected {
+		algorithm, err := registry.Lookup(id)
+		if err != nil {
+			t.Fatalf("Lookup(%q) returned unexpected error: %v", id, err)
+		}
+		if got := algorithm.Class(); got != class {
+			t.Errorf("%s.Class() = %v; want %v", id, got, class)
+		}
+	}
+}
+
+// TestAlgorithmSorters tests the aliasing guarantees of pkg.Sorter for every default algorithm,
+// plain and instrumented: Sort fills dst and leaves src alone, Sort(arr, arr) and SortInPlace
+// sort arr itself, and nothing past the input is written
+func TestAlgorithmSorters(t *testing.T) {
+	generator := pkg.NewRandomGeneratorWithSeed(7)
+	inputs := map[string][]int{
+		"Empty":      {},
+		"Single":     {5},
+		"Pair":       {2, 1},
+		"Duplicates": {3, -1, 3, 0, -1, 7, 0},
+		"Random":     generator.GenerateIntSlice(300, -50, 50),
+		"Large":      generator.GenerateIntSlice(5000, -1_000_000, 1_000_000),
+	}
+	const sentinel = 1 << 40
+
+	for _, algorithm := range DefaultRegistry().All() {
+		sorters := map[string]pkg.Sorter{"Sorter": algorithm.Sorter}
+		if algorithm.NewInstrumentedSorter != nil {
+			sorters["Instrumented"] = algorithm.NewInstrumentedSorter(pkg.NewOperationCounter())
+		}
+
+		for variant, sorter := range sorters {
+			for name, input := range inputs {
+				t.Run(algorithm.ID+"/"+variant+"/"+name, func(t *testing.T) {
+					expected := slices.Sorted(slices.Values(input))
+
+					src := slices.Clone(input)
+					dst := append(make([]int, len(src)), sentinel)
+					sorter.Sort(dst, src)
+					if !slices.Equal(src, input) {
+						t.Errorf("Sort modified src")
+					}
+					if !slices.Equal(dst[:len(src)], expected) || dst[len(src)] != sentinel {
+						t.Errorf("Sort(dst, src) left dst = %v; want %v followed by the sentinel", dst, expected)
+					}
+
+					arr := slices.Clone(input)
+					sorter.Sort(arr, arr)
+					if !slices.Equal(arr, expected) {
+						t.Errorf("Sort(arr, arr) left arr = %v; want %v", arr, expected)
+					}
+
+					backing := append(slices.Clone(input), sentinel)
+					sorter.SortInPlace(backing[:len(input)])
+					if !slices.Equal(backing[:len(input)], expected) || backing[len(input)] != sentinel {
+						t.Errorf("SortInPlace left %v; want %v followed by the sentinel", backing, expected)
+					}
+				})
+			}
+		}
+	}
+}
+
+// TestAlgorithmSortersShortDestination tests that every Sorter panics when dst cannot hold src
+func TestAlgorithmSortersShortDestination(t *testing.T) {
+	for _, algorithm := range DefaultRegistry().All() {
+		t.Run(algorithm.ID, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("Sort did not panic")
+				}
+			}()
+
+			algorithm.Sorter.Sort(make([]int, 2, 3), []int{3, 1, 2})
+		})
+	}
+}
+
+// TestAlgorithmStability runs the stability harness against every default algorithm through its
+// comparator or key form: algorithms declared stable must keep equal keys in input order, and
+// the harness must catch the others reordering them, so that no declaration is wrong either way
+func TestAlgorithmStability(t *testing.T) {
+	cases := pkg.StabilityCases(pkg.NewRandomGeneratorWithSeed(1), 300)
+
+	for _, algorithm := range DefaultRegistry().All() {
+		t.Run(algorithm.ID, func(t *testing.T) {
+			if algorithm.SortRecords == nil {
+				t.Fatal("SortRecords is nil")
+			}
+
+			report := pkg.VerifyStability(algorithm.SortRecords, cases)
+			if !report.Sorted || !report.Complete {
+				t.Fatalf("VerifyStability() = %s; want every record sorted", report)
+			}
+			if report.Stable() != algorithm.Stable {
+				t.Errorf("VerifyStability() = %s; want Stable() = %t as declared", report, algorithm.Stable)
+			}
+		})
+	}
+}
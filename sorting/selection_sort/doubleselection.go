@@ -0,0 +1,95 @@
+package selection_sort
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/JoaoVitor615/algorithms-in-go/pkg"
+)
+
+// DoubleSelectionSort sorts an array using double-ended Selection Sort
+// Each pass selects both the minimum and the maximum of the unsorted part and
+// swaps them to its two ends, halving the number of passes
+// Time Complexity: O(n²) in every case
+// Space Complexity: O(1)
+func DoubleSelectionSort(arr []int) []int {
+	return DoubleSelectionSortOrdered(arr)
+}
+
+// DoubleSelectionSortInstrumented sorts an array like DoubleSelectionSort and records
+// the comparisons, swaps and allocations it performs in counter
+func DoubleSelectionSortInstrumented(arr []int, counter *pkg.OperationCounter) []int {
+	if len(arr) <= 1 {
+		return slices.Clone(arr)
+	}
+
+	result := make([]int, len(arr))
+	copy(result, arr)
+	counter.Allocate(len(result))
+
+	doubleSelectionSort(result, pkg.CountComparisons(counter, cmp.Compare[int]), counter)
+	return result
+}
+
+// DoubleSelectionSortInPlace sorts an array in-place using double-ended Selection Sort
+func DoubleSelectionSortInPlace(arr []int) {
+	DoubleSelectionSortInPlaceFunc(arr, cmp.Compare[int])
+}
+
+// NewDoubleSorter returns a pkg.Sorter that runs DoubleSelectionSort and records the operations it performs in counter
+// counter may be nil when the caller does not need operation counts
+func NewDoubleSorter(counter *pkg.OperationCounter) pkg.Sorter {
+	return pkg.SorterFunc(func(arr []int) {
+		if len(arr) <= 1 {
+			return
+		}
+		doubleSelectionSort(arr, pkg.CountComparisons(counter, cmp.Compare[int]), counter)
+	})
+}
+
+// DoubleSelectionSortOrdered sorts a slice of any ordered type using double-ended Selection Sort
+func DoubleSelectionSortOrdered[T cmp.Ordered](arr []T) []T {
+	return DoubleSelectionSortFunc(arr, cmp.Compare[T])
+}
+
+// DoubleSelectionSortFunc sorts a slice using double-ended Selection Sort and a comparator function
+func DoubleSelectionSortFunc[T any](arr []T, compare func(a, b T) int) []T {
+	if len(arr) <= 1 {
+		return slices.Clone(arr)
+	}
+
+	// Make a copy to avoid modifying the original array
+	result := make([]T, len(arr))
+	copy(result, arr)
+
+	doubleSelectionSort(result, compare, nil)
+	return result
+}
+
+// DoubleSelectionSortInPlaceFunc sorts a slice in-place using double-ended Selection Sort and a comparator function
+func DoubleSelectionSortInPlaceFunc[T any](arr []T, compare func(a, b T) int) {
+	doubleSelectionSort(arr, compare, nil)
+}
+
+// doubleSelectionSort performs the double-ended Selection Sort in-place
+// counter may be nil when the caller does not need operation counts
+func doubleSelectionSort[T any](arr []T, compare func(a, b T) int, counter *pkg.OperationCounter) {
+	for low, high := 0, len(arr)-1; low < high; low, high = low+1, high-1 {
+		minIndex, maxIndex := low, low
+		for j := low + 1; j <= high; j++ {
+			if compare(arr[j], arr[minIndex]) < 0 {
+				minIndex = j
+			} else if compare(arr[j], arr[maxIndex]) > 0 {
+				maxIndex = j
+			}
+		}
+
+		swap(arr, low, minIndex, counter)
+
+		// The maximum was at low and has just been moved to where the minimum was
+		if maxIndex == low {
+			maxIndex = minIndex
+		}
+		swap(arr, high, maxIndex, counter)
+	}
+}
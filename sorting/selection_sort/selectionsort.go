@@ -0,0 +1,102 @@
+package selection_sort
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/JoaoVitor615/algorithms-in-go/pkg"
+)
+
+// SelectionSort sorts an array using the Selection Sort algorithm
+// Each pass selects the minimum of the unsorted part and swaps it into place,
+// so at most n-1 swaps are made whatever the input
+// Time Complexity: O(n²) in every case
+// Space Complexity: O(1)
+func SelectionSort(arr []int) []int {
+	return SelectionSortOrdered(arr)
+}
+
+// SelectionSortInstrumented sorts an array like SelectionSort and records
+// the comparisons, swaps and allocations it performs in counter
+func SelectionSortInstrumented(arr []int, counter *pkg.OperationCounter) []int {
+	if len(arr) <= 1 {
+		return slices.Clone(arr)
+	}
+
+	result := make([]int, len(arr))
+	copy(result, arr)
+	counter.Allocate(len(result))
+
+	selectionSort(result, pkg.CountComparisons(counter, cmp.Compare[int]), counter)
+	return result
+}
+
+// SelectionSortInPlace sorts an array in-place using the Selection Sort algorithm
+func SelectionSortInPlace(arr []int) {
+	SelectionSortInPlaceFunc(arr, cmp.Compare[int])
+}
+
+// NewSorter returns a pkg.Sorter that runs SelectionSort and records the operations it performs in counter
+// counter may be nil when the caller does not need operation counts
+func NewSorter(counter *pkg.OperationCounter) pkg.Sorter {
+	return pkg.SorterFunc(func(arr []int) {
+		if len(arr) <= 1 {
+			return
+		}
+		selectionSort(arr, pkg.CountComparisons(counter, cmp.Compare[int]), counter)
+	})
+}
+
+// SelectionSortOrdered sorts a slice of any ordered type (integers, floats, strings)
+// It returns a sorted copy and leaves the original slice untouched
+func SelectionSortOrdered[T cmp.Ordered](arr []T) []T {
+	return SelectionSortFunc(arr, cmp.Compare[T])
+}
+
+// SelectionSortFunc sorts a slice of any type using a comparator function
+// The comparator must return a negative number when a < b, zero when a == b
+// and a positive number when a > b, matching the contract of cmp.Compare
+func SelectionSortFunc[T any](arr []T, compare func(a, b T) int) []T {
+	if len(arr) <= 1 {
+		return slices.Clone(arr)
+	}
+
+	// Make a copy to avoid modifying the original array
+	result := make([]T, len(arr))
+	copy(result, arr)
+
+	selectionSort(result, compare, nil)
+	return result
+}
+
+// SelectionSortInPlaceFunc sorts a slice in-place using a comparator function
+func SelectionSortInPlaceFunc[T any](arr []T, compare func(a, b T) int) {
+	selectionSort(arr, compare, nil)
+}
+
+// selectionSort performs the Selection Sort in-place
+// counter may be nil when the caller does not need operation counts
+func selectionSort[T any](arr []T, compare func(a, b T) int, counter *pkg.OperationCounter) {
+	n := len(arr)
+
+	for i := 0; i < n-1; i++ {
+		minIndex := i
+		for j := i + 1; j < n; j++ {
+			if compare(arr[j], arr[minIndex]) < 0 {
+				minIndex = j
+			}
+		}
+
+		swap(arr, i, minIndex, counter)
+	}
+}
+
+// swap exchanges arr[i] and arr[j], skipping the writes when both are the same position
+func swap[T any](arr []T, i, j int, counter *pkg.OperationCounter) {
+	if i == j {
+		return
+	}
+
+	arr[i], arr[j] = arr[j], arr[i]
+	counter.Swap()
+}
@@ -0,0 +1,274 @@
+package selection_sort
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/JoaoVitor615/algorithms-in-go/pkg"
+)
+
+// selectionSortTestCases is the shared table used by the int and generic SelectionSort tests.
+var selectionSortTestCases = []struct {
+	name     string
+	input    []int
+	expected []int
+}{
+	{
+		name:     "Empty array",
+		input:    []int{},
+		expected: []int{},
+	},
+	{
+		name:     "Single element",
+		input:    []int{5},
+		expected: []int{5},
+	},
+	{
+		name:     "Already sorted array",
+		input:    []int{1, 2, 3, 4, 5},
+		expected: []int{1, 2, 3, 4, 5},
+	},
+	{
+		name:     "Reverse sorted array",
+		input:    []int{5, 4, 3, 2, 1},
+		expected: []int{1, 2, 3, 4, 5},
+	},
+	{
+		name:     "Unsorted array with even number of elements",
+		input:    []int{4, 2, 5, 1, 3, 6},
+		expected: []int{1, 2, 3, 4, 5, 6},
+	},
+	{
+		name:     "Array with duplicate elements",
+		input:    []int{4, 2, 5, 1, 3, 2, 4},
+		expected: []int{1, 2, 2, 3, 4, 4, 5},
+	},
+	{
+		name:     "Array with all same elements",
+		input:    []int{3, 3, 3, 3, 3},
+		expected: []int{3, 3, 3, 3, 3},
+	},
+	{
+		name:     "Array with negative numbers",
+		input:    []int{-5, 2, -3, 8, 1, -1},
+		expected: []int{-5, -3, -1, 1, 2, 8},
+	},
+	{
+		name:     "Large random array",
+		input:    []int{64, 34, 25, 12, 22, 11, 90, 88, 76, 50, 42},
+		expected: []int{11, 12, 22, 25, 34, 42, 50, 64, 76, 88, 90},
+	},
+}
+
+// selectionSorts lists every int variant of both selection sorts
+var selectionSorts = []struct {
+	name    string
+	sort    func([]int) []int
+	inPlace bool
+}{
+	{name: "SelectionSort", sort: SelectionSort},
+	{name: "SelectionSortInstrumented", sort: func(arr []int) []int { return SelectionSortInstrumented(arr, pkg.NewOperationCounter()) }},
+	{name: "SelectionSortFunc", sort: func(arr []int) []int { return SelectionSortFunc(arr, cmp.Compare[int]) }},
+	{name: "SelectionSortInPlace", sort: func(arr []int) []int {
+		SelectionSortInPlace(arr)
+		return arr
+	}, inPlace: true},
+	{name: "DoubleSelectionSort", sort: DoubleSelectionSort},
+	{name: "DoubleSelectionSortInstrumented", sort: func(arr []int) []int {
+		return DoubleSelectionSortInstrumented(arr, pkg.NewOperationCounter())
+	}},
+	{name: "DoubleSelectionSortFunc", sort: func(arr []int) []int { return DoubleSelectionSortFunc(arr, cmp.Compare[int]) }},
+	{name: "DoubleSelectionSortInPlace", sort: func(arr []int) []int {
+		DoubleSelectionSortInPlace(arr)
+		return arr
+	}, inPlace: true},
+}
+
+// TestSelectionSort runs the shared table against every variant
+func TestSelectionSort(t *testing.T) {
+	for _, variant := range selectionSorts {
+		for _, tc := range selectionSortTestCases {
+			t.Run(variant.name+"/"+tc.name, func(t *testing.T) {
+				input := slices.Clone(tc.input)
+				result := variant.sort(input)
+
+				if !reflect.DeepEqual(result, tc.expected) {
+					t.Errorf("%s(%v) = %v; want %v", variant.name, tc.input, result, tc.expected)
+				}
+				if !variant.inPlace && !reflect.DeepEqual(input, tc.input) {
+					t.Errorf("%s modified its input: got %v, want %v", variant.name, input, tc.input)
+				}
+			})
+		}
+	}
+}
+
+// TestSelectionSortDistributions checks both selection sorts against slices.Sort on every distribution
+func TestSelectionSortDistributions(t *testing.T) {
+	generator := pkg.NewRandomGeneratorWithSeed(42)
+
+	for _, distribution := range pkg.Distributions() {
+		for _, size := range []int{2, 3, 101, 500} {
+			input := generator.GenerateDistribution(distribution, size)
+			expected := slices.Clone(input)
+			slices.Sort(expected)
+
+			for _, variant := range selectionSorts {
+				t.Run(fmt.Sprintf("%v/%d/%s", distribution, size, variant.name), func(t *testing.T) {
+					if result := variant.sort(slices.Clone(input)); !reflect.DeepEqual(result, expected) {
+						t.Errorf("%s did not sort %d %v numbers", variant.name, size, distribution)
+					}
+				})
+			}
+		}
+	}
+}
+
+// TestSelectionSortGenericTypes tests the generic variants with floats, strings and a custom order
+func TestSelectionSortGenericTypes(t *testing.T) {
+	t.Run("Floats", func(t *testing.T) {
+		input := []float64{3.5, -1.25, 2.0, 0.5, -7.75}
+		expected := []float64{-7.75, -1.25, 0.5, 2.0, 3.5}
+
+		if result := SelectionSortOrdered(input); !reflect.DeepEqual(result, expected) {
+			t.Errorf("SelectionSortOrdered(%v) = %v; want %v", input, result, expected)
+		}
+		if result := DoubleSelectionSortOrdered(input); !reflect.DeepEqual(result, expected) {
+			t.Errorf("DoubleSelectionSortOrdered(%v) = %v; want %v", input, result, expected)
+		}
+	})
+
+	t.Run("Strings", func(t *testing.T) {
+		input := []string{"pear", "apple", "fig", "banana"}
+		expected := []string{"apple", "banana", "fig", "pear"}
+
+		if result := SelectionSortOrdered(input); !reflect.DeepEqual(result, expected) {
+			t.Errorf("SelectionSortOrdered(%v) = %v; want %v", input, result, expected)
+		}
+		if result := DoubleSelectionSortOrdered(input); !reflect.DeepEqual(result, expected) {
+			t.Errorf("DoubleSelectionSortOrdered(%v) = %v; want %v", input, result, expected)
+		}
+	})
+
+	t.Run("Descending comparator", func(t *testing.T) {
+		descending := func(a, b int) int { return cmp.Compare(b, a) }
+		expected := []int{5, 4, 3, 2, 1}
+
+		input := []int{4, 2, 5, 1, 3}
+		SelectionSortInPlaceFunc(input, descending)
+		if !reflect.DeepEqual(input, expected) {
+			t.Errorf("SelectionSortInPlaceFunc with descending comparator = %v; want %v", input, expected)
+		}
+
+		input = []int{4, 2, 5, 1, 3}
+		DoubleSelectionSortInPlaceFunc(input, descending)
+		if !reflect.DeepEqual(input, expected) {
+			t.Errorf("DoubleSelectionSortInPlaceFunc with descending comparator = %v; want %v", input, expected)
+		}
+	})
+}
+
+// TestSelectionSortInstrumented tests the operation counts of the instrumented versions
+func TestSelectionSortInstrumented(t *testing.T) {
+	testCases := []struct {
+		name        string
+		sort        func([]int, *pkg.OperationCounter) []int
+		input       []int
+		comparisons int64
+		swaps       int64
+	}{
+		{"Selection on sorted input makes no swaps", SelectionSortInstrumented, []int{1, 2, 3, 4, 5}, 10, 0},
+		{"Selection on reverse input swaps the outer pairs", SelectionSortInstrumented, []int{5, 4, 3, 2, 1}, 10, 2},
+		{"Double selection on sorted input makes no swaps", DoubleSelectionSortInstrumented, []int{1, 2, 3, 4, 5}, 12, 0},
+		{"Double selection on reverse input", DoubleSelectionSortInstrumented, []int{5, 4, 3, 2, 1}, 6, 2},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			counter := pkg.NewOperationCounter()
+			result := tc.sort(tc.input, counter)
+
+			if !slices.IsSorted(result) {
+				t.Errorf("instrumented sort of %v = %v; want sorted output", tc.input, result)
+			}
+			if counter.Comparisons != tc.comparisons || counter.Swaps != tc.swaps || counter.Writes != 2*tc.swaps {
+				t.Errorf("counted %d comparisons, %d swaps and %d writes; want %d, %d and %d",
+					counter.Comparisons, counter.Swaps, counter.Writes, tc.comparisons, tc.swaps, 2*tc.swaps)
+			}
+			if counter.Allocations != 1 {
+				t.Errorf("counted %d allocations; want 1", counter.Allocations)
+			}
+		})
+	}
+}
+
+// TestSelectionSortSwapBound checks that both selection sorts make fewer than n swaps on any input
+func TestSelectionSortSwapBound(t *testing.T) {
+	generator := pkg.NewRandomGeneratorWithSeed(7)
+
+	for _, distribution := range pkg.Distributions() {
+		input := generator.GenerateDistribution(distribution, 1000)
+
+		for name, sort := range map[string]func([]int, *pkg.OperationCounter) []int{
+			"SelectionSort":       SelectionSortInstrumented,
+			"DoubleSelectionSort": DoubleSelectionSortInstrumented,
+		} {
+			counter := pkg.NewOperationCounter()
+			sort(input, counter)
+
+			if counter.Swaps >= int64(len(input)) {
+				t.Errorf("%s made %d swaps on %d %v numbers; want fewer than n", name, counter.Swaps, len(input), distribution)
+			}
+		}
+	}
+}
+
+// BenchmarkSelectionSort compares single and double-ended Selection Sort
+func BenchmarkSelectionSort(b *testing.B) {
+	generator := pkg.NewRandomGeneratorWithSeed(42)
+
+	for _, size := range []int{100, 1000, 5000} {
+		input := generator.GenerateIntSliceDefault(size)
+
+		b.Run(fmt.Sprintf("SelectionSort/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				SelectionSort(input)
+			}
+		})
+		b.Run(fmt.Sprintf("DoubleSelectionSort/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				DoubleSelectionSort(input)
+			}
+		})
+	}
+}
+
+// FuzzSelectionSort checks the sort properties of every selection sort variant on fuzzed inputs
+func FuzzSelectionSort(f *testing.F) {
+	for _, tc := range selectionSortTestCases {
+		f.Add(pkg.BytesFromInts(tc.input))
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		input := pkg.IntsFromBytes(data)
+		checks := map[string]error{
+			"NewSorter":       pkg.CheckSorter(NewSorter(pkg.NewOperationCounter()), input),
+			"NewDoubleSorter": pkg.CheckSorter(NewDoubleSorter(pkg.NewOperationCounter()), input),
+		}
+		for _, s := range selectionSorts {
+			if s.inPlace {
+				checks[s.name] = pkg.CheckSortInPlace(func(arr []int) { s.sort(arr) }, input)
+			} else {
+				checks[s.name] = pkg.CheckSort(s.sort, input)
+			}
+		}
+		for name, err := range checks {
+			if err != nil {
+				t.Errorf("%s: %v", name, err)
+			}
+		}
+	})
+}
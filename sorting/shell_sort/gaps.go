@@ -0,0 +1,98 @@
+package shell_sort
+
+import (
+	"fmt"
+	"math"
+	"slices"
+)
+
+// GapSequence selects the gaps Shell Sort uses between its Insertion Sort passes
+type GapSequence int
+
+const (
+	// ShellGaps halves the array length until it reaches 1 (Shell, 1959), O(n²) worst case
+	ShellGaps GapSequence = iota
+	// KnuthGaps uses (3^k - 1) / 2 up to n/3: 1, 4, 13, 40, ... (Knuth, 1973), O(n^(3/2)) worst case
+	KnuthGaps
+	// SedgewickGaps uses 4^k + 3·2^(k-1) + 1 after 1: 1, 8, 23, 77, ... (Sedgewick, 1986), O(n^(4/3)) worst case
+	SedgewickGaps
+	// CiuraGaps uses the empirically tuned 1, 4, 10, 23, 57, 132, 301, 701, 1750 (Ciura, 2001),
+	// extended past 1750 by multiplying the previous gap by 2.25 and rounding down
+	CiuraGaps
+	// TokudaGaps uses ceil(h) with h = 2.25·h + 1 starting from 1: 1, 4, 9, 20, 46, ... (Tokuda, 1992)
+	TokudaGaps
+)
+
+// gapSequenceNames holds the display name of each GapSequence, indexed by its value
+var gapSequenceNames = []string{"Shell", "Knuth", "Sedgewick", "Ciura", "Tokuda"}
+
+// ciuraGaps is the sequence found experimentally by Ciura
+var ciuraGaps = []int{1, 4, 10, 23, 57, 132, 301, 701, 1750}
+
+// String returns the name of the gap sequence
+func (s GapSequence) String() string {
+	if s < 0 || int(s) >= len(gapSequenceNames) {
+		return fmt.Sprintf("GapSequence(%d)", int(s))
+	}
+	return gapSequenceNames[s]
+}
+
+// GapSequences returns every supported gap sequence, in declaration order
+func GapSequences() []GapSequence {
+	return []GapSequence{ShellGaps, KnuthGaps, SedgewickGaps, CiuraGaps, TokudaGaps}
+}
+
+// Gaps returns the gaps of sequence used to sort n elements, from the largest down to 1
+// Every gap is smaller than n, and an unknown sequence falls back to the Ciura gaps
+func Gaps(sequence GapSequence, n int) []int {
+	if n <= 1 {
+		return nil
+	}
+
+	var gaps []int
+	switch sequence {
+	case ShellGaps:
+		for gap := n / 2; gap > 0; gap /= 2 {
+			gaps = append(gaps, gap)
+		}
+		return gaps
+
+	case KnuthGaps:
+		limit := max((n+2)/3, 1)
+		for gap := 1; gap <= limit && gap < n; gap = 3*gap + 1 {
+			gaps = append(gaps, gap)
+		}
+
+	case SedgewickGaps:
+		gaps = append(gaps, 1)
+		for k := 1; ; k++ {
+			gap := 1<<(2*k) + 3<<(k-1) + 1
+			if gap >= n {
+				break
+			}
+			gaps = append(gaps, gap)
+		}
+
+	case TokudaGaps:
+		for h := 1.0; int(math.Ceil(h)) < n; h = 2.25*h + 1 {
+			gaps = append(gaps, int(math.Ceil(h)))
+		}
+
+	default:
+		for _, gap := range ciuraGaps {
+			if gap >= n {
+				break
+			}
+			gaps = append(gaps, gap)
+		}
+		if len(gaps) == len(ciuraGaps) {
+			for gap := ciuraGaps[len(ciuraGaps)-1] * 9 / 4; gap < n; gap = gap * 9 / 4 {
+				gaps = append(gaps, gap)
+			}
+		}
+	}
+
+	// The formulas build the sequences bottom-up, the passes run top-down
+	slices.Reverse(gaps)
+	return gaps
+}
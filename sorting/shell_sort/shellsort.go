@@ -0,0 +1,104 @@
+package shell_sort
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/JoaoVitor615/algorithms-in-go/pkg"
+	"github.com/JoaoVitor615/algorithms-in-go/sorting/insertion_sort"
+)
+
+// ShellSort sorts an array using Shell Sort with the Ciura gap sequence
+// Time Complexity: depends on the gap sequence, about O(n^(4/3)) on average with Ciura gaps
+// Space Complexity: O(1) besides the copy
+func ShellSort(arr []int) []int {
+	return ShellSortWithGaps(arr, CiuraGaps)
+}
+
+// ShellSortInstrumented sorts an array like ShellSort and records the
+// comparisons, element writes and allocations it performs in counter
+func ShellSortInstrumented(arr []int, counter *pkg.OperationCounter) []int {
+	return ShellSortWithGapsInstrumented(arr, CiuraGaps, counter)
+}
+
+// ShellSortInPlace sorts an array in-place using Shell Sort with the Ciura gap sequence
+func ShellSortInPlace(arr []int) {
+	ShellSortInPlaceFunc(arr, cmp.Compare[int])
+}
+
+// NewSorter returns a pkg.Sorter that runs ShellSort and records the operations it performs in counter
+// counter may be nil when the caller does not need operation counts
+func NewSorter(counter *pkg.OperationCounter) pkg.Sorter {
+	return pkg.SorterFunc(func(arr []int) {
+		if len(arr) <= 1 {
+			return
+		}
+		shellSort(arr, CiuraGaps, pkg.CountComparisons(counter, cmp.Compare[int]), counter)
+	})
+}
+
+// ShellSortOrdered sorts a slice of any ordered type (integers, floats, strings)
+// It returns a sorted copy and leaves the original slice untouched
+func ShellSortOrdered[T cmp.Ordered](arr []T) []T {
+	return ShellSortFunc(arr, cmp.Compare[T])
+}
+
+// ShellSortFunc sorts a slice of any type using a comparator function
+// The comparator must return a negative number when a < b, zero when a == b
+// and a positive number when a > b, matching the contract of cmp.Compare
+func ShellSortFunc[T any](arr []T, compare func(a, b T) int) []T {
+	return ShellSortWithGapsFunc(arr, CiuraGaps, compare)
+}
+
+// ShellSortInPlaceFunc sorts a slice in-place using a comparator function
+func ShellSortInPlaceFunc[T any](arr []T, compare func(a, b T) int) {
+	ShellSortInPlaceWithGapsFunc(arr, CiuraGaps, compare)
+}
+
+// ShellSortWithGaps sorts an array using Shell Sort with the given gap sequence
+func ShellSortWithGaps(arr []int, sequence GapSequence) []int {
+	return ShellSortWithGapsFunc(arr, sequence, cmp.Compare[int])
+}
+
+// ShellSortWithGapsInstrumented sorts an array like ShellSortWithGaps and records the
+// comparisons, element writes and allocations it performs in counter
+func ShellSortWithGapsInstrumented(arr []int, sequence GapSequence, counter *pkg.OperationCounter) []int {
+	if len(arr) <= 1 {
+		return slices.Clone(arr)
+	}
+
+	result := make([]int, len(arr))
+	copy(result, arr)
+	counter.Allocate(len(result))
+
+	shellSort(result, sequence, pkg.CountComparisons(counter, cmp.Compare[int]), counter)
+	return result
+}
+
+// ShellSortWithGapsFunc sorts a slice with the given gap sequence and a comparator function
+func ShellSortWithGapsFunc[T any](arr []T, sequence GapSequence, compare func(a, b T) int) []T {
+	if len(arr) <= 1 {
+		return slices.Clone(arr)
+	}
+
+	// Make a copy to avoid modifying the original array
+	result := make([]T, len(arr))
+	copy(result, arr)
+
+	shellSort(result, sequence, compare, nil)
+	return result
+}
+
+// ShellSortInPlaceWithGapsFunc sorts a slice in-place with the given gap sequence and a comparator function
+func ShellSortInPlaceWithGapsFunc[T any](arr []T, sequence GapSequence, compare func(a, b T) int) {
+	shellSort(arr, sequence, compare, nil)
+}
+
+// shellSort runs one gapped Insertion Sort pass per gap, from the largest down to 1
+// The final pass with gap 1 is a plain Insertion Sort over an almost sorted array
+// counter may be nil when the caller does not need operation counts
+func shellSort[T any](arr []T, sequence GapSequence, compare func(a, b T) int, counter *pkg.OperationCounter) {
+	for _, gap := range Gaps(sequence, len(arr)) {
+		insertion_sort.InsertionSortInPlaceWithGapInstrumented(arr, gap, compare, counter)
+	}
+}
@@ -0,0 +1,320 @@
+package shell_sort
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/JoaoVitor615/algorithms-in-go/pkg"
+)
+
+// shellSortTestCases is the shared table used by the int and generic ShellSort tests.
+var shellSortTestCases = []struct {
+	name     string
+	input    []int
+	expected []int
+}{
+	{
+		name:     "Empty array",
+		input:    []int{},
+		expected: []int{},
+	},
+	{
+		name:     "Single element",
+		input:    []int{5},
+		expected: []int{5},
+	},
+	{
+		name:     "Already sorted array",
+		input:    []int{1, 2, 3, 4, 5},
+		expected: []int{1, 2, 3, 4, 5},
+	},
+	{
+		name:     "Reverse sorted array",
+		input:    []int{5, 4, 3, 2, 1},
+		expected: []int{1, 2, 3, 4, 5},
+	},
+	{
+		name:     "Unsorted array with even number of elements",
+		input:    []int{4, 2, 5, 1, 3, 6},
+		expected: []int{1, 2, 3, 4, 5, 6},
+	},
+	{
+		name:     "Array with duplicate elements",
+		input:    []int{4, 2, 5, 1, 3, 2, 4},
+		expected: []int{1, 2, 2, 3, 4, 4, 5},
+	},
+	{
+		name:     "Array with all same elements",
+		input:    []int{3, 3, 3, 3, 3},
+		expected: []int{3, 3, 3, 3, 3},
+	},
+	{
+		name:     "Array with negative numbers",
+		input:    []int{-5, 2, -3, 8, 1, -1},
+		expected: []int{-5, -3, -1, 1, 2, 8},
+	},
+	{
+		name:     "Large random array",
+		input:    []int{64, 34, 25, 12, 22, 11, 90, 88, 76, 50, 42},
+		expected: []int{11, 12, 22, 25, 34, 42, 50, 64, 76, 88, 90},
+	},
+}
+
+// TestShellSort runs the shared table against the default variants
+func TestShellSort(t *testing.T) {
+	sorts := []struct {
+		name string
+		sort func([]int) []int
+	}{
+		{"ShellSort", ShellSort},
+		{"ShellSortInstrumented", func(arr []int) []int { return ShellSortInstrumented(arr, pkg.NewOperationCounter()) }},
+		{"ShellSortOrdered", ShellSortOrdered[int]},
+		{"ShellSortFunc", func(arr []int) []int { return ShellSortFunc(arr, cmp.Compare[int]) }},
+		{"ShellSortInPlace", func(arr []int) []int {
+			result := slices.Clone(arr)
+			ShellSortInPlace(result)
+			return result
+		}},
+	}
+
+	for _, variant := range sorts {
+		for _, tc := range shellSortTestCases {
+			t.Run(variant.name+"/"+tc.name, func(t *testing.T) {
+				input := slices.Clone(tc.input)
+				result := variant.sort(input)
+
+				if !reflect.DeepEqual(result, tc.expected) {
+					t.Errorf("%s(%v) = %v; want %v", variant.name, tc.input, result, tc.expected)
+				}
+				if variant.name != "ShellSortInPlace" && !reflect.DeepEqual(input, tc.input) {
+					t.Errorf("%s modified its input: got %v, want %v", variant.name, input, tc.input)
+				}
+			})
+		}
+	}
+}
+
+// TestShellSortWithGaps checks every gap sequence against slices.Sort on several distributions
+func TestShellSortWithGaps(t *testing.T) {
+	generator := pkg.NewRandomGeneratorWithSeed(42)
+	inputs := map[string][]int{
+		"Random":        generator.GenerateIntSlice(5000, 0, 5000),
+		"Nearly sorted": generator.GenerateNearlySortedSlice(5000, 0, 5000, 50),
+		"Reverse":       generator.GenerateReverseSortedSlice(5000, 0, 5000),
+		"Few unique":    generator.GenerateFewUniqueSlice(5000, 0, 5000, 4),
+		"Organ pipe":    generator.GenerateOrganPipeSlice(5000),
+	}
+
+	for _, sequence := range GapSequences() {
+		for _, tc := range shellSortTestCases {
+			t.Run(sequence.String()+"/"+tc.name, func(t *testing.T) {
+				if result := ShellSortWithGaps(tc.input, sequence); !reflect.DeepEqual(result, tc.expected) {
+					t.Errorf("ShellSortWithGaps(%v, %v) = %v; want %v", tc.input, sequence, result, tc.expected)
+				}
+			})
+		}
+
+		for name, input := range inputs {
+			t.Run(sequence.String()+"/"+name, func(t *testing.T) {
+				expected := slices.Clone(input)
+				slices.Sort(expected)
+
+				result := slices.Clone(input)
+				ShellSortInPlaceWithGapsFunc(result, sequence, cmp.Compare[int])
+				if !reflect.DeepEqual(result, expected) {
+					t.Errorf("ShellSortInPlaceWithGapsFunc did not sort %s input with %v gaps", name, sequence)
+				}
+			})
+		}
+	}
+}
+
+// TestGaps tests the gaps generated for each sequence
+func TestGaps(t *testing.T) {
+	testCases := []struct {
+		sequence GapSequence
+		n        int
+		expected []int
+	}{
+		{ShellGaps, 100, []int{50, 25, 12, 6, 3, 1}},
+		{KnuthGaps, 100, []int{13, 4, 1}},
+		{KnuthGaps, 121, []int{40, 13, 4, 1}},
+		{SedgewickGaps, 1000, []int{281, 77, 23, 8, 1}},
+		{CiuraGaps, 1000, []int{701, 301, 132, 57, 23, 10, 4, 1}},
+		{CiuraGaps, 10000, []int{8858, 3937, 1750, 701, 301, 132, 57, 23, 10, 4, 1}},
+		{TokudaGaps, 1000, []int{525, 233, 103, 46, 20, 9, 4, 1}},
+		{ShellGaps, 2, []int{1}},
+		{CiuraGaps, 2, []int{1}},
+		{TokudaGaps, 1, nil},
+		{GapSequence(99), 30, []int{23, 10, 4, 1}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%v/%d", tc.sequence, tc.n), func(t *testing.T) {
+			if result := Gaps(tc.sequence, tc.n); !reflect.DeepEqual(result, tc.expected) {
+				t.Errorf("Gaps(%v, %d) = %v; want %v", tc.sequence, tc.n, result, tc.expected)
+			}
+		})
+	}
+}
+
+// TestGapSequenceString tests the names of the gap sequences
+func TestGapSequenceString(t *testing.T) {
+	expected := []string{"Shell", "Knuth", "Sedgewick", "Ciura", "Tokuda"}
+	for i, sequence := range GapSequences() {
+		if sequence.String() != expected[i] {
+			t.Errorf("GapSequence(%d).String() = %q; want %q", i, sequence.String(), expected[i])
+		}
+	}
+
+	if name := GapSequence(-1).String(); name != "GapSequence(-1)" {
+		t.Errorf("GapSequence(-1).String() = %q; want %q", name, "GapSequence(-1)")
+	}
+}
+
+// TestShellSortGenericTypes tests the generic variants with floats, strings and a custom order
+func TestShellSortGenericTypes(t *testing.T) {
+	t.Run("Floats", func(t *testing.T) {
+		input := []float64{3.5, -1.25, 2.0, 0.5, -7.75}
+		expected := []float64{-7.75, -1.25, 0.5, 2.0, 3.5}
+
+		if result := ShellSortOrdered(input); !reflect.DeepEqual(result, expected) {
+			t.Errorf("ShellSortOrdered(%v) = %v; want %v", input, result, expected)
+		}
+	})
+
+	t.Run("Strings", func(t *testing.T) {
+		input := []string{"pear", "apple", "fig", "banana"}
+		expected := []string{"apple", "banana", "fig", "pear"}
+
+		if result := ShellSortOrdered(input); !reflect.DeepEqual(result, expected) {
+			t.Errorf("ShellSortOrdered(%v) = %v; want %v", input, result, expected)
+		}
+	})
+
+	t.Run("Descending comparator", func(t *testing.T) {
+		input := []int{4, 2, 5, 1, 3}
+		expected := []int{5, 4, 3, 2, 1}
+
+		ShellSortInPlaceFunc(input, func(a, b int) int { return cmp.Compare(b, a) })
+		if !reflect.DeepEqual(input, expected) {
+			t.Errorf("ShellSortInPlaceFunc with descending comparator = %v; want %v", input, expected)
+		}
+	})
+}
+
+// TestShellSortInstrumented tests the operation counts of the instrumented version
+func TestShellSortInstrumented(t *testing.T) {
+	testCases := []struct {
+		name        string
+		input       []int
+		comparisons int64
+		writes      int64
+	}{
+		{
+			name:        "Already sorted array only places each key",
+			input:       []int{1, 2, 3, 4, 5},
+			comparisons: 5,
+			writes:      5,
+		},
+		{
+			name:        "Reverse sorted array is mostly fixed by the gap 4 pass",
+			input:       []int{5, 4, 3, 2, 1},
+			comparisons: 8,
+			writes:      9,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			counter := pkg.NewOperationCounter()
+			result := ShellSortInstrumented(tc.input, counter)
+
+			if !reflect.DeepEqual(result, ShellSort(tc.input)) {
+				t.Errorf("ShellSortInstrumented(%v) = %v; want sorted output", tc.input, result)
+			}
+			if counter.Comparisons != tc.comparisons || counter.Writes != tc.writes {
+				t.Errorf("counted %d comparisons and %d writes; want %d and %d",
+					counter.Comparisons, counter.Writes, tc.comparisons, tc.writes)
+			}
+			if counter.Swaps != 0 || counter.Allocations != 1 {
+				t.Errorf("counted %d swaps and %d allocations; want 0 and 1", counter.Swaps, counter.Allocations)
+			}
+		})
+	}
+}
+
+// TestShellSortBeatsShellGaps checks that the tuned sequences need fewer comparisons than halving
+func TestShellSortBeatsShellGaps(t *testing.T) {
+	input := pkg.NewRandomGeneratorWithSeed(42).GenerateIntSlice(20000, 0, 20000)
+
+	comparisons := make(map[GapSequence]int64)
+	for _, sequence := range GapSequences() {
+		counter := pkg.NewOperationCounter()
+		ShellSortWithGapsInstrumented(input, sequence, counter)
+		comparisons[sequence] = counter.Comparisons
+	}
+
+	for _, sequence := range []GapSequence{SedgewickGaps, CiuraGaps, TokudaGaps} {
+		if comparisons[sequence] >= comparisons[ShellGaps] {
+			t.Errorf("%v gaps made %d comparisons; want fewer than the %d of Shell gaps",
+				sequence, comparisons[sequence], comparisons[ShellGaps])
+		}
+	}
+}
+
+// BenchmarkShellSortGaps compares the gap sequences on the same inputs
+func BenchmarkShellSortGaps(b *testing.B) {
+	generator := pkg.NewRandomGeneratorWithSeed(42)
+
+	for _, size := range []int{1000, 100000} {
+		inputs := []struct {
+			name  string
+			input []int
+		}{
+			{"Random", generator.GenerateIntSlice(size, 0, size)},
+			{"NearlySorted", generator.GenerateNearlySortedSlice(size, 0, size, size/100)},
+			{"FewUnique", generator.GenerateFewUniqueSlice(size, 0, size, 8)},
+			{"OrganPipe", generator.GenerateOrganPipeSlice(size)},
+		}
+
+		for _, in := range inputs {
+			for _, sequence := range GapSequences() {
+				b.Run(fmt.Sprintf("%s/%d/%v", in.name, size, sequence), func(b *testing.B) {
+					for i := 0; i < b.N; i++ {
+						ShellSortWithGaps(in.input, sequence)
+					}
+				})
+			}
+		}
+	}
+}
+
+// FuzzShellSort checks the sort properties of Shell Sort with every gap sequence on fuzzed inputs
+func FuzzShellSort(f *testing.F) {
+	for _, tc := range shellSortTestCases {
+		f.Add(pkg.BytesFromInts(tc.input))
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		input := pkg.IntsFromBytes(data)
+		checks := map[string]error{
+			"ShellSort":        pkg.CheckSort(ShellSort, input),
+			"ShellSortInPlace": pkg.CheckSortInPlace(ShellSortInPlace, input),
+			"NewSorter":        pkg.CheckSorter(NewSorter(pkg.NewOperationCounter()), input),
+		}
+		for _, sequence := range GapSequences() {
+			checks["ShellSortWithGaps/"+sequence.String()] = pkg.CheckSort(func(arr []int) []int {
+				return ShellSortWithGaps(arr, sequence)
+			}, input)
+		}
+		for name, err := range checks {
+			if err != nil {
+				t.Errorf("%s: %v", name, err)
+			}
+		}
+	})
+}
@@ -2,15 +2,18 @@ package sorting
 
 import (
 	"fmt"
+	"os"
+	"strconv"
+	"strings"
 
 	"github.com/JoaoVitor615/algorithms-in-go/pkg"
-	"github.com/JoaoVitor615/algorithms-in-go/sorting/merge_sort"
 )
 
 // Terminal handles all user interface interactions for sorting algorithms
 type Terminal struct {
-	useCase *UseCase
-	input   *pkg.InputReader
+	useCase      *UseCase
+	input        *pkg.InputReader
+	distribution pkg.Distribution // shape of the generated inputs, chosen from the algorithm menu
 }
 
 // NewTerminal creates a new Terminal instance
@@ -28,39 +31,44 @@ func RunSortingInterface() {
 }
 
 func (t *Terminal) showSortingMenu() {
+	algorithms := t.useCase.Algorithms()
+	compareOption := len(algorithms) + 1
+	backOption := len(algorithms) + 2
+
 	fmt.Println("\n\n[   Sorting Algorithms - Advanced Testing   ]")
 	fmt.Println("Choose a sorting algorithm:")
-	fmt.Println("1. Merge Sort")
-	fmt.Println("2. Quick Sort")
-	fmt.Println("3. Bubble Sort")
-	fmt.Println("4. Heap Sort (Coming Soon)")
-	fmt.Println("5. Insertion Sort")
-	fmt.Println("6. Back to main menu")
+	for i, algorithm := range algorithms {
+		fmt.Printf("%d. %s\n", i+1, algorithm.Name)
+	}
+	fmt.Printf("%d. Compare algorithms\n", compareOption)
+	fmt.Printf("%d. Back to main menu\n", backOption)
 	fmt.Println()
 
-	choice := t.getMenuChoice("Enter your choice (1-6): ")
-
-	switch choice {
-	case "1":
-		t.showAlgorithmMenu("Merge Sort")
-	case "2":
-		t.showAlgorithmMenu("Quick Sort")
-	case "3":
-		t.showAlgorithmMenu("Bubble Sort")
-	case "4":
-		fmt.Println("This algorithm is coming soon! Stay tuned. 🚀")
-		t.showSortingMenu()
-	case "5":
-		t.showAlgorithmMenu("Insertion Sort")
-	case "6":
+	choice, err := strconv.Atoi(t.getMenuChoice(fmt.Sprintf("Enter your choice (1-%d): ", backOption)))
+
+	switch {
+	case err == nil && choice >= 1 && choice <= len(algorithms):
+		t.showAlgorithmMenu(algorithms[choice-1])
+	case err == nil && choice == compareOption:
+		t.runComparison()
+	case err == nil && choice == backOption:
 		return
 	default:
-		fmt.Println("Invalid choice. Please select a valid option (1-6).")
+		fmt.Printf("Invalid choice. Please select a valid option (1-%d).\n", backOption)
 		t.showSortingMenu()
 	}
 }
 
-func (t *Terminal) showAlgorithmMenu(algorithmName string) {
+func (t *Terminal) showAlgorithmMenu(algorithm Algorithm) {
+	algorithmName := algorithm.Name
+
+	// Only algorithms with a callback sort offer the visualization, the options after it shift up otherwise
+	visualizeOption, distributionOption := 0, 8
+	if algorithm.SortWithCallback != nil {
+		visualizeOption, distributionOption = 8, 9
+	}
+	backOption := distributionOption + 1
+
 	fmt.Printf("\n\n[   %s - Advanced Testing   ]\n", algorithmName)
 	fmt.Println("Choose a testing option:")
 	fmt.Println()
@@ -71,34 +79,66 @@ func (t *Terminal) showAlgorithmMenu(algorithmName string) {
 	fmt.Println("5. Benchmark 5,000 random numbers")
 	fmt.Println("6. Benchmark 10,000 random numbers")
 	fmt.Println("7. Run all benchmarks")
-	fmt.Println("8. Back to sorting menu")
+	if algorithm.SortWithCallback != nil {
+		fmt.Printf("%d. Visualize step by step\n", visualizeOption)
+	}
+	fmt.Printf("%d. Change input distribution (current: %s)\n", distributionOption, t.distribution.Name())
+	fmt.Printf("%d. Back to sorting menu\n", backOption)
 	fmt.Println()
 
-	choice := t.getMenuChoice("Enter your choice (1-8): ")
+	choice, err := strconv.Atoi(t.getMenuChoice(fmt.Sprintf("Enter your choice (1-%d): ", backOption)))
+	if err != nil {
+		choice = 0
+	}
 
-	switch choice {
-	case "1":
+	switch {
+	case choice == 1:
 		t.runManualInput(algorithmName)
-	case "2":
+	case choice == 2:
 		t.runCustomRandom(algorithmName)
-	case "3":
+	case choice == 3:
 		t.runBenchmark(algorithmName, 500)
-	case "4":
+	case choice == 4:
 		t.runBenchmark(algorithmName, 1000)
-	case "5":
+	case choice == 5:
 		t.runBenchmark(algorithmName, 5000)
-	case "6":
+	case choice == 6:
 		t.runBenchmark(algorithmName, 10000)
-	case "7":
+	case choice == 7:
 		t.runAllBenchmarks(algorithmName)
-	case "8":
+	case choice == visualizeOption && algorithm.SortWithCallback != nil:
+		t.runVisualization(algorithmName)
+	case choice == distributionOption:
+		t.chooseDistribution()
+		t.showAlgorithmMenu(algorithm)
+	case choice == backOption:
 		t.showSortingMenu()
 	default:
-		fmt.Println("Invalid choice. Please select a valid option (1-8).")
-		t.showAlgorithmMenu(algorithmName)
+		fmt.Printf("Invalid choice. Please select a valid option (1-%d).\n", backOption)
+		t.showAlgorithmMenu(algorithm)
 	}
 }
 
+func (t *Terminal) chooseDistribution() {
+	distributions := pkg.Distributions()
+
+	fmt.Println("\n\n[   Input Distribution   ]")
+	fmt.Println("Choose how random inputs are generated:")
+	for i, distribution := range distributions {
+		fmt.Printf("%d. %s\n", i+1, distribution.Name())
+	}
+	fmt.Println()
+
+	choice := t.input.ReadIntOrDefault(fmt.Sprintf("Enter your choice (1-%d): ", len(distributions)), 1, len(distributions))
+	if choice == -1 {
+		fmt.Printf("Invalid choice. Keeping %s.\n", t.distribution.Name())
+		return
+	}
+
+	t.distribution = distributions[choice-1]
+	fmt.Printf("🎲 Input distribution set to %s\n", t.distribution.Name())
+}
+
 func (t *Terminal) runManualInput(algorithmName string) {
 	pkg.PrintSubHeader(fmt.Sprintf("%s - Manual Input Mode", algorithmName))
 	fmt.Println("Enter numbers one by one and press Enter. To stop and sort, just press Enter on an empty line.")
@@ -109,23 +149,20 @@ func (t *Terminal) runManualInput(algorithmName string) {
 		return
 	}
 
-	result := t.useCase.ManualSort(algorithmName, numbers)
+	result, err := t.useCase.ManualSort(algorithmName, numbers)
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
 
-	if result.IsArray {
-		fmt.Println("\nOriginal Array:")
-		pkg.PrintSlice(numbers)
+	fmt.Println("\nOriginal Array:")
+	pkg.PrintSlice(numbers)
 
-		fmt.Println("Sorted Array:")
-		pkg.PrintSlice(result.SortedArray)
-	} else {
-		fmt.Println("\nOriginal List:")
-		pkg.PrintSlice(numbers)
-
-		fmt.Println("Sorted List:")
-		t.printList(result.SortedList)
-	}
+	fmt.Println("Sorted Array:")
+	pkg.PrintSlice(result.SortedArray)
 
 	pkg.PrintPerformanceInfo(result.Count, result.Duration, result.Analysis)
+	pkg.PrintOperations(result.Operations)
 }
 
 func (t *Terminal) runCustomRandom(algorithmName string) {
@@ -138,28 +175,37 @@ func (t *Terminal) runCustomRandom(algorithmName string) {
 		return
 	}
 
-	fmt.Printf("\n🎲 Generating %s random numbers...\n", pkg.FormatNumber(count))
+	fmt.Printf("\n🎲 Generating %s random numbers (%s)...\n", pkg.FormatNumber(count), t.distribution.Name())
 
-	result := t.useCase.CustomRandomSort(algorithmName, count)
+	result, err := t.useCase.CustomRandomSort(algorithmName, count, t.distribution)
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
 
 	fmt.Printf("📝 Random list with %s numbers generated successfully!\n", pkg.FormatNumber(count))
 	fmt.Println("🔄 Starting sort...")
 
 	pkg.PrintPerformanceInfo(result.Count, result.Duration, result.Analysis)
+	pkg.PrintOperations(result.Operations)
 	t.askToShowList(result, count)
 }
 
 func (t *Terminal) runBenchmark(algorithmName string, count int) {
 	pkg.PrintSubHeader(fmt.Sprintf("%s Benchmark: %s Random Numbers", algorithmName, pkg.FormatNumber(count)))
 
-	fmt.Printf("🎲 Generating %s random numbers...\n", pkg.FormatNumber(count))
+	fmt.Printf("🎲 Generating %s random numbers (%s)...\n", pkg.FormatNumber(count), t.distribution.Name())
 
-	result := t.useCase.BenchmarkSort(algorithmName, count)
+	result, err := t.useCase.BenchmarkSort(algorithmName, count, t.distribution)
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
 
 	fmt.Printf("📝 List with %s numbers generated successfully!\n", pkg.FormatNumber(count))
 	fmt.Println("🔄 Starting sort...")
 
-	pkg.PrintDetailedPerformance(result.Count, result.Duration, result.Analysis, result.IsSorted)
+	pkg.PrintDetailedPerformance(result.Count, result.Duration, result.Analysis, result.IsSorted, result.Operations)
 }
 
 func (t *Terminal) runAllBenchmarks(algorithmName string) {
@@ -167,16 +213,149 @@ func (t *Terminal) runAllBenchmarks(algorithmName string) {
 	fmt.Println("This will test sorting performance with different input sizes...")
 	fmt.Println()
 
-	summary := t.useCase.RunAllBenchmarks(algorithmName)
+	options := DefaultBenchmarkOptions
+	options.Distribution = t.distribution
+
+	summary, err := t.useCase.RunBenchmarks(algorithmName, options)
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
 
 	for i, result := range summary.Results {
-		fmt.Printf("[%d/%d] Generating %s numbers... Sorting... Done in %v ✅\n",
-			i+1, len(summary.Results), pkg.FormatNumber(result.Count), result.Duration)
+		fmt.Printf("[%d/%d] Sorting %s numbers %d times... Median %v (95%% CI %v – %v) ✅\n",
+			i+1, len(summary.Results), pkg.FormatNumber(result.Count), result.Stats.Runs,
+			result.Duration, result.Stats.CILow, result.Stats.CIHigh)
 	}
 
 	pkg.PrintBenchmarkSummary(summary)
 }
 
+func (t *Terminal) runVisualization(algorithmName string) {
+	pkg.PrintSubHeader(fmt.Sprintf("%s - Step-by-Step Visualization", algorithmName))
+
+	count := t.input.ReadIntOrDefault("Enter the number of elements to visualize (2-40): ", 2, 40)
+	if count == -1 {
+		fmt.Println("Invalid input. Please enter a number between 2 and 40.")
+		return
+	}
+
+	frames, err := t.useCase.VisualizeSort(algorithmName, count, t.distribution)
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
+
+	fmt.Println("\nChoose a playback speed:")
+	for i, speed := range VisualizationSpeeds {
+		fmt.Printf("%d. %s (%v per step)\n", i+1, speed.Name, speed.Delay)
+	}
+	speed := t.input.ReadIntOrDefault(fmt.Sprintf("Enter your choice (1-%d): ", len(VisualizationSpeeds)), 1, len(VisualizationSpeeds)) - 1
+	if speed < 0 {
+		speed = DefaultVisualizationSpeed
+		fmt.Printf("Invalid choice. Using %s.\n", VisualizationSpeeds[speed].Name)
+	}
+
+	fmt.Printf("\n🎬 Recorded %s steps on %s numbers (%s). Type p and press Enter to play.\n",
+		pkg.FormatNumber(len(frames)-2), pkg.FormatNumber(count), t.distribution.Name())
+
+	newVisualizer(algorithmName, frames, os.Stdout, speed).run(readCommands(t.input))
+}
+
+func (t *Terminal) runComparison() {
+	algorithms := t.useCase.Algorithms()
+
+	pkg.PrintSubHeader("Compare Algorithms")
+	for i, algorithm := range algorithms {
+		fmt.Printf("%d. %s\n", i+1, algorithm.Name)
+	}
+
+	selection := t.input.ReadString("\nAlgorithms to compare, separated by commas (press Enter for all): ")
+	selected, err := parseAlgorithmSelection(selection, algorithms)
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
+
+	if t.input.ReadYesNo(fmt.Sprintf("Input distribution is %s. Change it? (y/n): ", t.distribution.Name())) {
+		t.chooseDistribution()
+	}
+
+	options := CompareOptions{
+		Algorithms:    selected,
+		Sizes:         DefaultBenchmarkOptions.Sizes,
+		Distributions: []pkg.Distribution{t.distribution},
+		Runs:          DefaultBenchmarkOptions.Runs,
+		WarmupRuns:    DefaultBenchmarkOptions.WarmupRuns,
+	}
+
+	fmt.Printf("\n🏁 Racing %d algorithms on identical %s inputs...\n", len(selected), t.distribution.Name())
+
+	comparison, err := t.useCase.Compare(options)
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
+
+	t.printComparison(comparison)
+}
+
+func (t *Terminal) printComparison(comparison Comparison) {
+	for _, comparisonCase := range comparison.Cases {
+		fmt.Println("\n" + strings.Repeat("=", 80))
+		fmt.Printf("   %s numbers, %s\n", pkg.FormatNumber(comparisonCase.Count), comparisonCase.Distribution.Name())
+		fmt.Println(strings.Repeat("=", 80))
+		fmt.Printf("%-6s %-16s %-15s %-15s %-12s %-10s\n", "Rank", "Algorithm", "Median", "± StdDev", "vs fastest", "Status")
+		fmt.Println(strings.Repeat("-", 80))
+
+		for _, entry := range comparisonCase.Entries {
+			status := "✅"
+			if !entry.Matches {
+				status = "❌"
+			}
+
+			fmt.Printf("%-6d %-16s %-15v %-15v %-12s %-10s\n",
+				entry.Rank,
+				entry.Name,
+				entry.Stats.Median,
+				entry.Stats.StdDev,
+				fmt.Sprintf("%.2fx", entry.Relative),
+				status)
+		}
+	}
+
+	fmt.Println(strings.Repeat("=", 80))
+	fmt.Printf("⏱️  %d timed runs per algorithm after %d warm-up, every algorithm sorted the same dataset\n",
+		comparison.Runs, comparison.WarmupRuns)
+
+	if comparison.Agree() {
+		fmt.Println("✅ Verification: all algorithms produced the same sorted output")
+	} else {
+		fmt.Println("❌ Warning: some algorithms did not produce the sorted dataset")
+	}
+}
+
+// parseAlgorithmSelection maps menu numbers such as "1, 3" to algorithm IDs, an empty selection means all
+func parseAlgorithmSelection(selection string, algorithms []Algorithm) ([]string, error) {
+	var ids []string
+
+	if strings.TrimSpace(selection) == "" {
+		for _, algorithm := range algorithms {
+			ids = append(ids, algorithm.ID)
+		}
+		return ids, nil
+	}
+
+	for _, field := range strings.Split(selection, ",") {
+		choice, err := strconv.Atoi(strings.TrimSpace(field))
+		if err != nil || choice < 1 || choice > len(algorithms) {
+			return nil, fmt.Errorf("invalid algorithm choice %q, use numbers between 1 and %d", strings.TrimSpace(field), len(algorithms))
+		}
+		ids = append(ids, algorithms[choice-1].ID)
+	}
+	return ids, nil
+}
+
 func (t *Terminal) getMenuChoice(prompt string) string {
 	return t.input.ReadString(prompt)
 }
@@ -185,43 +364,12 @@ func (t *Terminal) askToShowList(result SortResult, count int) {
 	if count <= 50 {
 		if t.input.ReadYesNo("\nDo you want to see the sorted list? (y/n): ") {
 			fmt.Println("\nSorted List:")
-			if result.IsArray {
-				pkg.PrintSlice(result.SortedArray)
-			} else {
-				t.printList(result.SortedList)
-			}
+			pkg.PrintSlice(result.SortedArray)
 		}
 	} else if count <= 1000 {
 		if t.input.ReadYesNo("\nDo you want to see the first 20 numbers of the sorted list? (y/n): ") {
 			fmt.Println("\nFirst 20 numbers of sorted list:")
-			if result.IsArray {
-				pkg.PrintSlicePartial(result.SortedArray, 20)
-			} else {
-				t.printListPartial(result.SortedList, 20)
-			}
+			pkg.PrintSlicePartial(result.SortedArray, 20)
 		}
 	}
 }
-
-func (t *Terminal) printList(node *merge_sort.Node) {
-	current := node
-	for current != nil {
-		fmt.Printf("%d -> ", current.Value)
-		current = current.Next
-	}
-	fmt.Println("nil")
-}
-
-func (t *Terminal) printListPartial(node *merge_sort.Node, maxCount int) {
-	partial := t.useCase.GetListPartial(node, maxCount)
-
-	for _, value := range partial {
-		fmt.Printf("%d -> ", value)
-	}
-
-	if len(partial) == maxCount {
-		fmt.Println("... (and more)")
-	} else {
-		fmt.Println("nil")
-	}
-}
@@ -0,0 +1,388 @@
+package tim_sort
+
+import "github.com/JoaoVitor615/algorithms-in-go/pkg"
+
+// sorter holds the state of one Timsort pass: the pending runs and the merge buffer
+type sorter[T any] struct {
+	arr     []T
+	compare func(a, b T) int
+	counter *pkg.OperationCounter
+
+	// minGallop adapts to the data, galloping pays off on runs with long streaks
+	minGallop int
+	tmp       []T
+
+	// Pending runs, run i starts at runBase[i] and has runLen[i] elements
+	runBase []int
+	runLen  []int
+}
+
+// pushRun pushes a run onto the pending run stack
+func (ts *sorter[T]) pushRun(base, length int) {
+	ts.runBase = append(ts.runBase, base)
+	ts.runLen = append(ts.runLen, length)
+}
+
+// mergeCollapse merges adjacent runs until the stack invariants hold again
+// for the top runs X, Y, Z (Z being the most recent) and the run W below them:
+//
+//	|W| > |X| + |Y|, |X| > |Y| + |Z| and |Y| > |Z|
+//
+// Checking W as well keeps the invariant on the whole stack, not only the top three runs
+func (ts *sorter[T]) mergeCollapse() {
+	for len(ts.runLen) > 1 {
+		n := len(ts.runLen) - 2
+		runLen := ts.runLen
+
+		if n > 0 && runLen[n-1] <= runLen[n]+runLen[n+1] ||
+			n > 1 && runLen[n-2] <= runLen[n-1]+runLen[n] {
+			// Merge the smaller of X and Z with Y
+			if runLen[n-1] < runLen[n+1] {
+				n--
+			}
+		} else if runLen[n] > runLen[n+1] {
+			return
+		}
+
+		ts.mergeAt(n)
+	}
+}
+
+// mergeForceCollapse merges all pending runs into one, at the end of the sort
+func (ts *sorter[T]) mergeForceCollapse() {
+	for len(ts.runLen) > 1 {
+		n := len(ts.runLen) - 2
+		if n > 0 && ts.runLen[n-1] < ts.runLen[n+1] {
+			n--
+		}
+		ts.mergeAt(n)
+	}
+}
+
+// mergeAt merges the runs at stack indices i and i+1, which must be the
+// second and third to last or the two last runs on the stack
+func (ts *sorter[T]) mergeAt(i int) {
+	base1, len1 := ts.runBase[i], ts.runLen[i]
+	base2, len2 := ts.runBase[i+1], ts.runLen[i+1]
+
+	ts.runLen[i] = len1 + len2
+	if i == len(ts.runLen)-3 {
+		ts.runBase[i+1] = ts.runBase[i+2]
+		ts.runLen[i+1] = ts.runLen[i+2]
+	}
+	ts.runBase = ts.runBase[:len(ts.runBase)-1]
+	ts.runLen = ts.runLen[:len(ts.runLen)-1]
+
+	// Elements of run1 that are not greater than the first element of run2 are already in place
+	k := gallopRight(ts.arr[base2], ts.arr[base1:base1+len1], 0, ts.compare)
+	base1 += k
+	len1 -= k
+	if len1 == 0 {
+		return
+	}
+
+	// Elements of run2 that are not less than the last element of run1 are already in place
+	len2 = gallopLeft(ts.arr[base1+len1-1], ts.arr[base2:base2+len2], len2-1, ts.compare)
+	if len2 == 0 {
+		return
+	}
+
+	// Copy the shorter run to the buffer
+	if len1 <= len2 {
+		ts.mergeLow(base1, len1, base2, len2)
+	} else {
+		ts.mergeHigh(base1, len1, base2, len2)
+	}
+}
+
+// buffer returns a temporary slice of at least n elements, growing the merge buffer when needed
+func (ts *sorter[T]) buffer(n int) []T {
+	if len(ts.tmp) < n {
+		size := max(n, min(2*len(ts.tmp), len(ts.arr)/2))
+		ts.tmp = make([]T, size)
+		ts.counter.Allocate(size)
+	}
+	return ts.tmp
+}
+
+// gallopLeft returns the position where key should be inserted in the sorted slice arr,
+// before any element equal to it, searching outwards from hint with exponential steps
+func gallopLeft[T any](key T, arr []T, hint int, compare func(a, b T) int) int {
+	lastOfs, ofs := 0, 1
+
+	if compare(key, arr[hint]) > 0 {
+		// Gallop right until arr[hint+lastOfs] < key <= arr[hint+ofs]
+		maxOfs := len(arr) - hint
+		for ofs < maxOfs && compare(key, arr[hint+ofs]) > 0 {
+			lastOfs = ofs
+			ofs = 2*ofs + 1
+		}
+		ofs = min(ofs, maxOfs)
+		lastOfs, ofs = hint+lastOfs, hint+ofs
+	} else {
+		// Gallop left until arr[hint-ofs] < key <= arr[hint-lastOfs]
+		maxOfs := hint + 1
+		for ofs < maxOfs && compare(key, arr[hint-ofs]) <= 0 {
+			lastOfs = ofs
+			ofs = 2*ofs + 1
+		}
+		ofs = min(ofs, maxOfs)
+		lastOfs, ofs = hint-ofs, hint-lastOfs
+	}
+
+	// Binary search between arr[lastOfs] < key <= arr[ofs]
+	lastOfs++
+	for lastOfs < ofs {
+		mid := lastOfs + (ofs-lastOfs)/2
+		if compare(key, arr[mid]) > 0 {
+			lastOfs = mid + 1
+		} else {
+			ofs = mid
+		}
+	}
+	return ofs
+}
+
+// gallopRight is like gallopLeft but returns the position after any element equal to key
+func gallopRight[T any](key T, arr []T, hint int, compare func(a, b T) int) int {
+	lastOfs, ofs := 0, 1
+
+	if compare(key, arr[hint]) < 0 {
+		// Gallop left until arr[hint-ofs] <= key < arr[hint-lastOfs]
+		maxOfs := hint + 1
+		for ofs < maxOfs && compare(key, arr[hint-ofs]) < 0 {
+			lastOfs = ofs
+			ofs = 2*ofs + 1
+		}
+		ofs = min(ofs, maxOfs)
+		lastOfs, ofs = hint-ofs, hint-lastOfs
+	} else {
+		// Gallop right until arr[hint+lastOfs] <= key < arr[hint+ofs]
+		maxOfs := len(arr) - hint
+		for ofs < maxOfs && compare(key, arr[hint+ofs]) >= 0 {
+			lastOfs = ofs
+			ofs = 2*ofs + 1
+		}
+		ofs = min(ofs, maxOfs)
+		lastOfs, ofs = hint+lastOfs, hint+ofs
+	}
+
+	// Binary search between arr[lastOfs] <= key < arr[ofs]
+	lastOfs++
+	for lastOfs < ofs {
+		mid := lastOfs + (ofs-lastOfs)/2
+		if compare(key, arr[mid]) < 0 {
+			ofs = mid
+		} else {
+			lastOfs = mid + 1
+		}
+	}
+	return ofs
+}
+
+// mergeLow merges two adjacent runs front to back, copying run1 (the shorter one) to the buffer
+// It expects arr[base1] > arr[base2] and the last element of run1 greater than every element of run2
+func (ts *sorter[T]) mergeLow(base1, len1, base2, len2 int) {
+	arr, compare, counter := ts.arr, ts.compare, ts.counter
+	tmp := ts.buffer(len1)
+	copy(tmp, arr[base1:base1+len1])
+
+	cursor1, cursor2, dest := 0, base2, base1
+	arr[dest] = arr[cursor2]
+	counter.Write(1)
+	dest++
+	cursor2++
+	len2--
+
+	gallop := ts.minGallop
+
+	if len2 > 0 && len1 > 1 {
+	outer:
+		for {
+			count1, count2 := 0, 0
+
+			// One element at a time until one run keeps winning
+			for {
+				if compare(arr[cursor2], tmp[cursor1]) < 0 {
+					arr[dest] = arr[cursor2]
+					dest, cursor2, len2 = dest+1, cursor2+1, len2-1
+					count1, count2 = 0, count2+1
+					counter.Write(1)
+					if len2 == 0 {
+						break outer
+					}
+				} else {
+					arr[dest] = tmp[cursor1]
+					dest, cursor1, len1 = dest+1, cursor1+1, len1-1
+					count1, count2 = count1+1, 0
+					counter.Write(1)
+					if len1 == 1 {
+						break outer
+					}
+				}
+				if count1 >= gallop || count2 >= gallop {
+					break
+				}
+			}
+
+			// Galloping until neither run wins by a long streak
+			for {
+				count1 = gallopRight(arr[cursor2], tmp[cursor1:cursor1+len1], 0, compare)
+				if count1 != 0 {
+					copy(arr[dest:dest+count1], tmp[cursor1:cursor1+count1])
+					dest, cursor1, len1 = dest+count1, cursor1+count1, len1-count1
+					counter.Write(count1)
+					if len1 <= 1 {
+						break outer
+					}
+				}
+				arr[dest] = arr[cursor2]
+				dest, cursor2, len2 = dest+1, cursor2+1, len2-1
+				counter.Write(1)
+				if len2 == 0 {
+					break outer
+				}
+
+				count2 = gallopLeft(tmp[cursor1], arr[cursor2:cursor2+len2], 0, compare)
+				if count2 != 0 {
+					copy(arr[dest:dest+count2], arr[cursor2:cursor2+count2])
+					dest, cursor2, len2 = dest+count2, cursor2+count2, len2-count2
+					counter.Write(count2)
+					if len2 == 0 {
+						break outer
+					}
+				}
+				arr[dest] = tmp[cursor1]
+				dest, cursor1, len1 = dest+1, cursor1+1, len1-1
+				counter.Write(1)
+				if len1 == 1 {
+					break outer
+				}
+
+				gallop--
+				if count1 < minGallop && count2 < minGallop {
+					break
+				}
+			}
+
+			// Penalize leaving gallop mode
+			gallop = max(gallop, 0) + 2
+		}
+	}
+
+	ts.minGallop = max(gallop, 1)
+
+	if len1 == 1 {
+		// The last element of run1 goes after what is left of run2
+		copy(arr[dest:dest+len2], arr[cursor2:cursor2+len2])
+		arr[dest+len2] = tmp[cursor1]
+		counter.Write(len2 + 1)
+	} else if len1 > 0 {
+		copy(arr[dest:dest+len1], tmp[cursor1:cursor1+len1])
+		counter.Write(len1)
+	}
+}
+
+// mergeHigh merges two adjacent runs back to front, copying run2 (the shorter one) to the buffer
+// It expects arr[base1] > arr[base2] and the last element of run1 greater than every element of run2
+func (ts *sorter[T]) mergeHigh(base1, len1, base2, len2 int) {
+	arr, compare, counter := ts.arr, ts.compare, ts.counter
+	tmp := ts.buffer(len2)
+	copy(tmp, arr[base2:base2+len2])
+
+	cursor1, cursor2, dest := base1+len1-1, len2-1, base2+len2-1
+	arr[dest] = arr[cursor1]
+	counter.Write(1)
+	dest--
+	cursor1--
+	len1--
+
+	gallop := ts.minGallop
+
+	if len1 > 0 && len2 > 1 {
+	outer:
+		for {
+			count1, count2 := 0, 0
+
+			// One element at a time until one run keeps winning
+			for {
+				if compare(tmp[cursor2], arr[cursor1]) < 0 {
+					arr[dest] = arr[cursor1]
+					dest, cursor1, len1 = dest-1, cursor1-1, len1-1
+					count1, count2 = count1+1, 0
+					counter.Write(1)
+					if len1 == 0 {
+						break outer
+					}
+				} else {
+					arr[dest] = tmp[cursor2]
+					dest, cursor2, len2 = dest-1, cursor2-1, len2-1
+					count1, count2 = 0, count2+1
+					counter.Write(1)
+					if len2 == 1 {
+						break outer
+					}
+				}
+				if count1 >= gallop || count2 >= gallop {
+					break
+				}
+			}
+
+			// Galloping until neither run wins by a long streak
+			for {
+				count1 = len1 - gallopRight(tmp[cursor2], arr[base1:base1+len1], len1-1, compare)
+				if count1 != 0 {
+					dest, cursor1, len1 = dest-count1, cursor1-count1, len1-count1
+					copy(arr[dest+1:dest+1+count1], arr[cursor1+1:cursor1+1+count1])
+					counter.Write(count1)
+					if len1 == 0 {
+						break outer
+					}
+				}
+				arr[dest] = tmp[cursor2]
+				dest, cursor2, len2 = dest-1, cursor2-1, len2-1
+				counter.Write(1)
+				if len2 == 1 {
+					break outer
+				}
+
+				count2 = len2 - gallopLeft(arr[cursor1], tmp[:len2], len2-1, compare)
+				if count2 != 0 {
+					dest, cursor2, len2 = dest-count2, cursor2-count2, len2-count2
+					copy(arr[dest+1:dest+1+count2], tmp[cursor2+1:cursor2+1+count2])
+					counter.Write(count2)
+					if len2 <= 1 {
+						break outer
+					}
+				}
+				arr[dest] = arr[cursor1]
+				dest, cursor1, len1 = dest-1, cursor1-1, len1-1
+				counter.Write(1)
+				if len1 == 0 {
+					break outer
+				}
+
+				gallop--
+				if count1 < minGallop && count2 < minGallop {
+					break
+				}
+			}
+
+			// Penalize leaving gallop mode
+			gallop = max(gallop, 0) + 2
+		}
+	}
+
+	ts.minGallop = max(gallop, 1)
+
+	if len2 == 1 {
+		// The first element of run2 goes before what is left of run1
+		dest, cursor1 = dest-len1, cursor1-len1
+		copy(arr[dest+1:dest+1+len1], arr[cursor1+1:cursor1+1+len1])
+		arr[dest] = tmp[cursor2]
+		counter.Write(len1 + 1)
+	} else if len2 > 0 {
+		copy(arr[dest-len2+1:dest+1], tmp[:len2])
+		counter.Write(len2)
+	}
+}
@@ -0,0 +1,164 @@
+package tim_sort
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/JoaoVitor615/algorithms-in-go/pkg"
+	"github.com/JoaoVitor615/algorithms-in-go/sorting/insertion_sort"
+)
+
+// minMerge is the smallest array that is split into runs, shorter arrays are sorted with binary insertion
+const minMerge = 32
+
+// minGallop is the initial number of consecutive wins of one run before a merge switches to galloping
+const minGallop = 7
+
+// TimSort sorts an array using the Timsort algorithm
+// Time Complexity: O(n log n) worst case, O(n) on already sorted or reverse sorted input
+// Space Complexity: O(n)
+func TimSort(arr []int) []int {
+	return TimSortOrdered(arr)
+}
+
+// TimSortInstrumented sorts an array like TimSort and records the comparisons,
+// merge writes, run reversals and allocations it performs in counter
+// Elements shifted by the binary insertion that extends short runs are not counted as writes
+func TimSortInstrumented(arr []int, counter *pkg.OperationCounter) []int {
+	if len(arr) <= 1 {
+		return slices.Clone(arr)
+	}
+
+	result := make([]int, len(arr))
+	copy(result, arr)
+	counter.Allocate(len(result))
+
+	timSort(result, pkg.CountComparisons(counter, cmp.Compare[int]), counter)
+	return result
+}
+
+// TimSortInPlace sorts an array in-place using the Timsort algorithm
+func TimSortInPlace(arr []int) {
+	TimSortInPlaceFunc(arr, cmp.Compare[int])
+}
+
+// NewSorter returns a pkg.Sorter that runs TimSort and records the operations it performs in counter
+// counter may be nil when the caller does not need operation counts
+func NewSorter(counter *pkg.OperationCounter) pkg.Sorter {
+	return pkg.SorterFunc(func(arr []int) {
+		if len(arr) <= 1 {
+			return
+		}
+		timSort(arr, pkg.CountComparisons(counter, cmp.Compare[int]), counter)
+	})
+}
+
+// TimSortOrdered sorts a slice of any ordered type (integers, floats, strings)
+// It returns a sorted copy and leaves the original slice untouched
+func TimSortOrdered[T cmp.Ordered](arr []T) []T {
+	return TimSortFunc(arr, cmp.Compare[T])
+}
+
+// TimSortFunc sorts a slice of any type using a comparator function
+// The comparator must return a negative number when a < b, zero when a == b
+// and a positive number when a > b, matching the contract of cmp.Compare
+// Equal elements keep their original relative order
+func TimSortFunc[T any](arr []T, compare func(a, b T) int) []T {
+	if len(arr) <= 1 {
+		return slices.Clone(arr)
+	}
+
+	result := make([]T, len(arr))
+	copy(result, arr)
+
+	timSort(result, compare, nil)
+	return result
+}
+
+// TimSortInPlaceFunc sorts a slice in-place using a comparator function
+func TimSortInPlaceFunc[T any](arr []T, compare func(a, b T) int) {
+	timSort(arr, compare, nil)
+}
+
+// timSort finds the natural runs of arr, extends the short ones to minRun elements
+// with binary insertion and merges them while keeping the run stack balanced
+// counter may be nil when the caller does not need operation counts
+func timSort[T any](arr []T, compare func(a, b T) int, counter *pkg.OperationCounter) {
+	n := len(arr)
+	if n < 2 {
+		return
+	}
+
+	// Small arrays are a single run extended by binary insertion
+	if n < minMerge {
+		runLen := countRunAndMakeAscending(arr, 0, compare, counter)
+		insertion_sort.InsertionSortInPlaceOptimizedFromFunc(arr, runLen, compare)
+		return
+	}
+
+	ts := &sorter[T]{arr: arr, compare: compare, counter: counter, minGallop: minGallop}
+	minRun := minRunLength(n)
+
+	for low := 0; low < n; {
+		runLen := countRunAndMakeAscending(arr, low, compare, counter)
+
+		// Extend short runs to minRun elements, or to the end of the array,
+		// inserting only the elements after the natural run
+		if runLen < minRun {
+			extended := min(minRun, n-low)
+			insertion_sort.InsertionSortInPlaceOptimizedFromFunc(arr[low:low+extended], runLen, compare)
+			runLen = extended
+		}
+
+		ts.pushRun(low, runLen)
+		ts.mergeCollapse()
+		low += runLen
+	}
+
+	ts.mergeForceCollapse()
+}
+
+// minRunLength returns the minimum run length for an array of n elements
+// It takes the six most significant bits of n, adding one if any of the remaining bits is set,
+// so that n divided by the result is a power of two or slightly less than one
+func minRunLength(n int) int {
+	remainder := 0
+	for n >= minMerge {
+		remainder |= n & 1
+		n >>= 1
+	}
+	return n + remainder
+}
+
+// countRunAndMakeAscending returns the length of the run starting at arr[low]
+// A run is either non-descending or strictly descending, the latter is reversed in place
+// Descending runs must be strict so that reversing them keeps equal elements in order
+func countRunAndMakeAscending[T any](arr []T, low int, compare func(a, b T) int, counter *pkg.OperationCounter) int {
+	high := low + 1
+	if high == len(arr) {
+		return 1
+	}
+
+	if compare(arr[high], arr[low]) < 0 {
+		high++
+		for high < len(arr) && compare(arr[high], arr[high-1]) < 0 {
+			high++
+		}
+		reverse(arr[low:high], counter)
+	} else {
+		high++
+		for high < len(arr) && compare(arr[high], arr[high-1]) >= 0 {
+			high++
+		}
+	}
+
+	return high - low
+}
+
+// reverse reverses arr in place
+func reverse[T any](arr []T, counter *pkg.OperationCounter) {
+	for i, j := 0, len(arr)-1; i < j; i, j = i+1, j-1 {
+		arr[i], arr[j] = arr[j], arr[i]
+		counter.Swap()
+	}
+}
@@ -0,0 +1,428 @@
+package tim_sort
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/JoaoVitor615/algorithms-in-go/pkg"
+)
+
+// timSortTestCases is the shared table used by the int and generic TimSort tests.
+var timSortTestCases = []struct {
+	name     string
+	input    []int
+	expected []int
+}{
+	{
+		name:     "Empty array",
+		input:    []int{},
+		expected: []int{},
+	},
+	{
+		name:     "Single element",
+		input:    []int{5},
+		expected: []int{5},
+	},
+	{
+		name:     "Already sorted array",
+		input:    []int{1, 2, 3, 4, 5},
+		expected: []int{1, 2, 3, 4, 5},
+	},
+	{
+		name:     "Reverse sorted array",
+		input:    []int{5, 4, 3, 2, 1},
+		expected: []int{1, 2, 3, 4, 5},
+	},
+	{
+		name:     "Unsorted array with even number of elements",
+		input:    []int{4, 2, 5, 1, 3, 6},
+		expected: []int{1, 2, 3, 4, 5, 6},
+	},
+	{
+		name:     "Array with duplicate elements",
+		input:    []int{4, 2, 5, 1, 3, 2, 4},
+		expected: []int{1, 2, 2, 3, 4, 4, 5},
+	},
+	{
+		name:     "Array with all same elements",
+		input:    []int{3, 3, 3, 3, 3},
+		expected: []int{3, 3, 3, 3, 3},
+	},
+	{
+		name:     "Array with negative numbers",
+		input:    []int{-5, 2, -3, 8, 1, -1},
+		expected: []int{-5, -3, -1, 1, 2, 8},
+	},
+	{
+		name:     "Large random array",
+		input:    []int{64, 34, 25, 12, 22, 11, 90, 88, 76, 50, 42},
+		expected: []int{11, 12, 22, 25, 34, 42, 50, 64, 76, 88, 90},
+	},
+}
+
+// TestTimSort runs the shared table against every variant
+func TestTimSort(t *testing.T) {
+	sorts := []struct {
+		name string
+		sort func([]int) []int
+	}{
+		{"TimSort", TimSort},
+		{"TimSortInstrumented", func(arr []int) []int { return TimSortInstrumented(arr, pkg.NewOperationCounter()) }},
+		{"TimSortOrdered", TimSortOrdered[int]},
+		{"TimSortFunc", func(arr []int) []int { return TimSortFunc(arr, cmp.Compare[int]) }},
+		{"TimSortInPlace", func(arr []int) []int {
+			result := slices.Clone(arr)
+			TimSortInPlace(result)
+			return result
+		}},
+	}
+
+	for _, variant := range sorts {
+		for _, tc := range timSortTestCases {
+			t.Run(variant.name+"/"+tc.name, func(t *testing.T) {
+				input := slices.Clone(tc.input)
+				result := variant.sort(input)
+
+				if !reflect.DeepEqual(result, tc.expected) {
+					t.Errorf("%s(%v) = %v; want %v", variant.name, tc.input, result, tc.expected)
+				}
+				if variant.name != "TimSortInPlace" && !reflect.DeepEqual(input, tc.input) {
+					t.Errorf("%s modified its input: got %v, want %v", variant.name, input, tc.input)
+				}
+			})
+		}
+	}
+}
+
+// partiallySortedInputs builds the kind of data Timsort is designed for: mostly ordered with some disorder
+func partiallySortedInputs(n int) map[string][]int {
+	generator := pkg.NewRandomGeneratorWithSeed(42)
+	inputs := make(map[string][]int)
+
+	ascending := make([]int, n)
+	for i := range ascending {
+		ascending[i] = i
+	}
+	descending := slices.Clone(ascending)
+	slices.Reverse(descending)
+
+	inputs["Ascending"] = ascending
+	inputs["Descending"] = descending
+	inputs["Random"] = generator.GenerateIntSlice(n, 0, n)
+	inputs["Few unique"] = generator.GenerateIntSlice(n, 0, 4)
+
+	// A sorted log with a batch of new unsorted entries appended at the end
+	appended := slices.Clone(ascending[:n-n/20])
+	inputs["Sorted with random tail"] = append(appended, generator.GenerateIntSlice(n/20, 0, n)...)
+
+	// A sorted array where a few elements were updated
+	nearly := slices.Clone(ascending)
+	for i := 0; i < n/100; i++ {
+		pair := generator.GenerateIntSlice(2, 0, n-1)
+		nearly[pair[0]], nearly[pair[1]] = nearly[pair[1]], nearly[pair[0]]
+	}
+	inputs["Nearly sorted"] = nearly
+
+	// Concatenated sorted files, as when combining exports from several sources
+	var chunks []int
+	for len(chunks) < n {
+		chunk := generator.GenerateIntSlice(min(n/8, n-len(chunks)), 0, n)
+		slices.Sort(chunk)
+		chunks = append(chunks, chunk...)
+	}
+	inputs["Sorted chunks"] = chunks
+
+	// Alternating ascending and descending runs of varying length
+	sawtooth := make([]int, 0, n)
+	for length := 50; len(sawtooth) < n; length += 37 {
+		run := generator.GenerateIntSlice(min(length, n-len(sawtooth)), 0, n)
+		slices.Sort(run)
+		if length%2 == 0 {
+			slices.Reverse(run)
+		}
+		sawtooth = append(sawtooth, run...)
+	}
+	inputs["Sawtooth"] = sawtooth
+
+	organPipe := make([]int, n)
+	for i := range organPipe {
+		organPipe[i] = min(i, n-1-i)
+	}
+	inputs["Organ pipe"] = organPipe
+
+	return inputs
+}
+
+// TestTimSortPartiallySorted checks TimSort against slices.Sort on real-world shaped data
+func TestTimSortPartiallySorted(t *testing.T) {
+	for _, size := range []int{31, 32, 33, 64, 1000, 100000} {
+		for name, input := range partiallySortedInputs(size) {
+			t.Run(fmt.Sprintf("%s/%d", name, size), func(t *testing.T) {
+				expected := slices.Clone(input)
+				slices.Sort(expected)
+
+				if result := TimSort(input); !reflect.DeepEqual(result, expected) {
+					t.Errorf("TimSort did not sort %d elements of %s input", size, name)
+				}
+			})
+		}
+	}
+}
+
+// TestTimSortExploitsRuns checks that presorted data costs far fewer comparisons than n log n
+func TestTimSortExploitsRuns(t *testing.T) {
+	const n = 100000
+	inputs := partiallySortedInputs(n)
+
+	testCases := []struct {
+		name           string
+		maxComparisons int64
+	}{
+		{"Ascending", n - 1},
+		{"Descending", n - 1},
+		{"Organ pipe", 2 * n},
+		{"Sorted with random tail", 2 * n},
+		{"Sorted chunks", 4 * n},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			counter := pkg.NewOperationCounter()
+			TimSortInstrumented(inputs[tc.name], counter)
+
+			if counter.Comparisons > tc.maxComparisons {
+				t.Errorf("TimSort made %d comparisons on %s input; want at most %d",
+					counter.Comparisons, tc.name, tc.maxComparisons)
+			}
+		})
+	}
+}
+
+// TestTimSortStability sorts tagged records by key and checks that equal keys keep their order
+func TestTimSortStability(t *testing.T) {
+	type record struct {
+		key   int
+		index int
+	}
+
+	generator := pkg.NewRandomGeneratorWithSeed(7)
+	for _, size := range []int{20, 1000, 50000} {
+		for name, keys := range map[string][]int{
+			"Few unique":   generator.GenerateIntSlice(size, 0, 8),
+			"Random":       generator.GenerateIntSlice(size, 0, size/4),
+			"Sorted tails": append(generator.GenerateIntSlice(size/2, 0, 4), generator.GenerateIntSlice(size-size/2, 0, 4)...),
+		} {
+			t.Run(fmt.Sprintf("%s/%d", name, size), func(t *testing.T) {
+				records := make([]record, len(keys))
+				for i, key := range keys {
+					records[i] = record{key, i}
+				}
+
+				result := TimSortFunc(records, func(a, b record) int { return cmp.Compare(a.key, b.key) })
+
+				expected := slices.Clone(records)
+				slices.SortStableFunc(expected, func(a, b record) int { return cmp.Compare(a.key, b.key) })
+				if !reflect.DeepEqual(result, expected) {
+					t.Errorf("TimSortFunc is not stable on %d %s records", size, name)
+				}
+			})
+		}
+	}
+}
+
+// TestTimSortGenericTypes tests the generic variants with floats, strings and a custom order
+func TestTimSortGenericTypes(t *testing.T) {
+	t.Run("Floats", func(t *testing.T) {
+		input := []float64{3.5, -1.25, 2.0, 0.5, -7.75}
+		expected := []float64{-7.75, -1.25, 0.5, 2.0, 3.5}
+
+		if result := TimSortOrdered(input); !reflect.DeepEqual(result, expected) {
+			t.Errorf("TimSortOrdered(%v) = %v; want %v", input, result, expected)
+		}
+	})
+
+	t.Run("Strings", func(t *testing.T) {
+		input := []string{"pear", "apple", "fig", "banana"}
+		expected := []string{"apple", "banana", "fig", "pear"}
+
+		if result := TimSortOrdered(input); !reflect.DeepEqual(result, expected) {
+			t.Errorf("TimSortOrdered(%v) = %v; want %v", input, result, expected)
+		}
+	})
+
+	t.Run("Descending comparator", func(t *testing.T) {
+		input := []int{4, 2, 5, 1, 3}
+		expected := []int{5, 4, 3, 2, 1}
+
+		TimSortInPlaceFunc(input, func(a, b int) int { return cmp.Compare(b, a) })
+		if !reflect.DeepEqual(input, expected) {
+			t.Errorf("TimSortInPlaceFunc with descending comparator = %v; want %v", input, expected)
+		}
+	})
+}
+
+// TestTimSortInstrumented tests the operation counts of the instrumented version
+func TestTimSortInstrumented(t *testing.T) {
+	const n = 1000
+	ascending := make([]int, n)
+	for i := range ascending {
+		ascending[i] = i
+	}
+	descending := slices.Clone(ascending)
+	slices.Reverse(descending)
+
+	testCases := []struct {
+		name        string
+		input       []int
+		comparisons int64
+		swaps       int64
+		allocations int64
+	}{
+		{"Already sorted array is a single run", ascending, n - 1, 0, 1},
+		{"Reverse sorted array is a single reversed run", descending, n - 1, n / 2, 1},
+		{"Short sorted array is not compared again by binary insertion", ascending[:minMerge-1], minMerge - 2, 0, 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			counter := pkg.NewOperationCounter()
+			result := TimSortInstrumented(tc.input, counter)
+
+			if !reflect.DeepEqual(result, ascending[:len(tc.input)]) {
+				t.Errorf("TimSortInstrumented did not sort the %s", tc.name)
+			}
+			if counter.Comparisons != tc.comparisons || counter.Swaps != tc.swaps || counter.Allocations != tc.allocations {
+				t.Errorf("counted %d comparisons, %d swaps and %d allocations; want %d, %d and %d",
+					counter.Comparisons, counter.Swaps, counter.Allocations, tc.comparisons, tc.swaps, tc.allocations)
+			}
+		})
+	}
+}
+
+// TestMinRunLength tests that minrun stays between minMerge/2 and minMerge
+func TestMinRunLength(t *testing.T) {
+	testCases := []struct {
+		n        int
+		expected int
+	}{
+		{1, 1},
+		{31, 31},
+		{32, 16},
+		{33, 17},
+		{64, 16},
+		{65, 17},
+		{1000, 32},
+		{1024, 16},
+		{1 << 20, 16},
+		{1<<20 + 1, 17},
+	}
+
+	for _, tc := range testCases {
+		if result := minRunLength(tc.n); result != tc.expected {
+			t.Errorf("minRunLength(%d) = %d; want %d", tc.n, result, tc.expected)
+		}
+	}
+}
+
+// TestRunStackInvariants pushes runs of varying lengths and checks the stack after every collapse
+func TestRunStackInvariants(t *testing.T) {
+	generator := pkg.NewRandomGeneratorWithSeed(3)
+
+	for _, lengths := range [][]int{
+		{120, 80, 25, 20, 30, 16, 16, 16, 200, 17, 18},
+		generator.GenerateIntSlice(200, 16, 300),
+	} {
+		var arr []int
+		for _, length := range lengths {
+			run := generator.GenerateIntSlice(length, 0, 1000)
+			slices.Sort(run)
+			arr = append(arr, run...)
+		}
+		expected := slices.Clone(arr)
+		slices.Sort(expected)
+
+		ts := &sorter[int]{arr: arr, compare: cmp.Compare[int], minGallop: minGallop}
+		base := 0
+		for _, length := range lengths {
+			ts.pushRun(base, length)
+			ts.mergeCollapse()
+			base += length
+
+			runLen := ts.runLen
+			for i := len(runLen) - 1; i > 0; i-- {
+				if runLen[i-1] <= runLen[i] || i > 1 && runLen[i-2] <= runLen[i-1]+runLen[i] {
+					t.Fatalf("run stack %v breaks the invariants at index %d", runLen, i)
+				}
+			}
+		}
+		ts.mergeForceCollapse()
+
+		if !reflect.DeepEqual(arr, expected) {
+			t.Errorf("merging the runs %v did not sort the array", lengths)
+		}
+	}
+}
+
+// TestGallop tests the insertion points returned by gallopLeft and gallopRight
+func TestGallop(t *testing.T) {
+	arr := []int{1, 2, 2, 2, 3, 5, 8, 8, 13}
+
+	for _, key := range []int{0, 1, 2, 4, 8, 13, 20} {
+		for hint := range arr {
+			left := gallopLeft(key, arr, hint, cmp.Compare[int])
+			if expected, _ := slices.BinarySearch(arr, key); left != expected {
+				t.Errorf("gallopLeft(%d, hint %d) = %d; want %d", key, hint, left, expected)
+			}
+
+			right := gallopRight(key, arr, hint, cmp.Compare[int])
+			if expected, _ := slices.BinarySearch(arr, key+1); right != expected {
+				t.Errorf("gallopRight(%d, hint %d) = %d; want %d", key, hint, right, expected)
+			}
+		}
+	}
+}
+
+// BenchmarkTimSort compares TimSort with slices.SortStableFunc on random and partially sorted data
+func BenchmarkTimSort(b *testing.B) {
+	inputs := partiallySortedInputs(100000)
+
+	for _, name := range []string{"Random", "Nearly sorted", "Sorted chunks", "Sawtooth"} {
+		input := inputs[name]
+
+		b.Run(name+"/TimSort", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				TimSort(input)
+			}
+		})
+		b.Run(name+"/SortStableFunc", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				slices.SortStableFunc(slices.Clone(input), cmp.Compare[int])
+			}
+		})
+	}
+}
+
+// FuzzTimSort checks the sort properties of every Tim Sort variant on fuzzed inputs
+func FuzzTimSort(f *testing.F) {
+	for _, tc := range timSortTestCases {
+		f.Add(pkg.BytesFromInts(tc.input))
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		input := pkg.IntsFromBytes(data)
+		checks := map[string]error{
+			"TimSort":        pkg.CheckSort(TimSort, input),
+			"TimSortOrdered": pkg.CheckSort(TimSortOrdered[int], input),
+			"TimSortInPlace": pkg.CheckSortInPlace(TimSortInPlace, input),
+			"NewSorter":      pkg.CheckSorter(NewSorter(pkg.NewOperationCounter()), input),
+		}
+		for name, err := range checks {
+			if err != nil {
+				t.Errorf("%s: %v", name, err)
+			}
+		}
+	})
+}
@@ -1,36 +1,44 @@
 package sorting
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/JoaoVitor615/algorithms-in-go/pkg"
-	"github.com/JoaoVitor615/algorithms-in-go/sorting/bubble_sort"
-	"github.com/JoaoVitor615/algorithms-in-go/sorting/insertion_sort"
-	"github.com/JoaoVitor615/algorithms-in-go/sorting/merge_sort"
-	"github.com/JoaoVitor615/algorithms-in-go/sorting/quick_sort"
 )
 
 // UseCase represents the business logic layer for sorting operations
 type UseCase struct {
 	generator *pkg.RandomGenerator
+	registry  *Registry
 }
 
 // NewUseCase creates a new UseCase instance
 func NewUseCase() *UseCase {
 	return &UseCase{
 		generator: pkg.NewRandomGenerator(),
+		registry:  DefaultRegistry(),
+	}
+}
+
+// NewUseCaseWithSeed creates a UseCase whose random inputs are reproducible from seed
+func NewUseCaseWithSeed(seed int64) *UseCase {
+	return &UseCase{
+		generator: pkg.NewRandomGeneratorWithSeed(seed),
+		registry:  DefaultRegistry(),
 	}
 }
 
 // SortResult contains the result of a sorting operation
 type SortResult struct {
-	SortedList  *merge_sort.Node // For linked list algorithms
-	SortedArray []int            // For array algorithms
+	Algorithm   string          // ID of the algorithm that produced the result
+	Environment pkg.Environment // Machine and seed the sort ran with
+	SortedArray []int           // Sorted copy of the input, never shared with the caller's slice
 	Duration    time.Duration
 	Count       int
 	IsSorted    bool
 	Analysis    pkg.PerformanceAnalysis
-	IsArray     bool // Flag to indicate if result is array or linked list
+	Operations  *pkg.OperationCounter // Measured operations, nil when the algorithm has no instrumented sorter
 }
 
 // Type aliases for convenience
@@ -38,213 +46,249 @@ type BenchmarkSummary = pkg.BenchmarkSummary
 type BenchmarkResult = pkg.BenchmarkResult
 type ScalingAnalysis = pkg.ScalingAnalysis
 
+// Algorithms returns the algorithms available to this use case
+func (uc *UseCase) Algorithms() []Algorithm {
+	return uc.registry.All()
+}
+
 // ManualSort sorts a manually created list using the specified algorithm
-func (uc *UseCase) ManualSort(algorithmName string, numbers []int) SortResult {
+func (uc *UseCase) ManualSort(algorithmName string, numbers []int) (SortResult, error) {
+	algorithm, err := uc.registry.Lookup(algorithmName)
+	if err != nil {
+		return SortResult{}, err
+	}
+
 	if len(numbers) == 0 {
 		return SortResult{
-			SortedList:  nil,
+			Algorithm:   algorithm.ID,
+			Environment: pkg.CaptureEnvironment(0),
 			SortedArray: []int{},
 			Duration:    0,
 			Count:       0,
 			IsSorted:    true,
-			Analysis:    pkg.PerformanceAnalysis{},
-			IsArray:     uc.algorithmUsesArray(algorithmName),
-		}
+			Analysis:    pkg.PerformanceAnalysis{Class: algorithm.Class()},
+		}, nil
 	}
 
-	count := len(numbers)
 	startTime := time.Now()
+	return uc.executeSort(algorithm, numbers, startTime), nil
+}
 
-	if uc.algorithmUsesArray(algorithmName) {
-		// Array-based algorithms
-		sortedArray := uc.executeSortArray(algorithmName, numbers)
-		duration := time.Since(startTime)
+// CustomRandomSort generates and sorts a random list of specified size shaped by distribution
+func (uc *UseCase) CustomRandomSort(algorithmName string, count int, distribution pkg.Distribution) (SortResult, error) {
+	algorithm, err := uc.registry.Lookup(algorithmName)
+	if err != nil {
+		return SortResult{}, err
+	}
 
-		return SortResult{
-			SortedList:  nil,
-			SortedArray: sortedArray,
-			Duration:    duration,
-			Count:       count,
-			IsSorted:    pkg.IsSortedSlice(sortedArray),
-			Analysis:    pkg.CalculateAnalysis(count, duration),
-			IsArray:     true,
-		}
-	} else {
-		// Linked list algorithms
-		head := uc.createListFromSlice(numbers)
-		sortedList := uc.executeSortList(algorithmName, head)
-		duration := time.Since(startTime)
+	// Generate the input before starting the clock, so only the sort is timed
+	numbers := uc.generator.GenerateDistribution(distribution, count)
+	startTime := time.Now()
+	result := uc.executeSort(algorithm, numbers, startTime)
+	result.Environment.Seed = uc.generator.Seed()
+	return result, nil
+}
 
-		return SortResult{
-			SortedList:  sortedList,
-			SortedArray: nil,
-			Duration:    duration,
-			Count:       count,
-			IsSorted:    uc.verifySorted(sortedList),
-			Analysis:    pkg.CalculateAnalysis(count, duration),
-			IsArray:     false,
-		}
-	}
+// BenchmarkSort runs a benchmark with predefined size on input shaped by distribution
+// It sorts exactly like CustomRandomSort; the terminal only reports the result in more detail
+func (uc *UseCase) BenchmarkSort(algorithmName string, count int, distribution pkg.Distribution) (SortResult, error) {
+	return uc.CustomRandomSort(algorithmName, count, distribution)
 }
 
-// CustomRandomSort generates and sorts a random list of specified size
-func (uc *UseCase) CustomRandomSort(algorithmName string, count int) SortResult {
-	startTime := time.Now()
+// BenchmarkOptions controls how RunBenchmarks measures each input size
+type BenchmarkOptions struct {
+	Sizes        []int            // input sizes, in the order they are reported
+	Runs         int              // timed runs per size, each on a fresh random input
+	WarmupRuns   int              // untimed runs per size before the timed ones
+	Distribution pkg.Distribution // shape of the generated inputs, Uniform by default
+}
 
-	if uc.algorithmUsesArray(algorithmName) {
-		// Array-based algorithms
-		numbers := uc.generator.GenerateIntSliceDefault(count)
-		sortedArray := uc.executeSortArray(algorithmName, numbers)
-		duration := time.Since(startTime)
+// DefaultBenchmarkOptions are the options used by RunAllBenchmarks
+var DefaultBenchmarkOptions = BenchmarkOptions{
+	Sizes:      []int{500, 1000, 5000, 10000},
+	Runs:       5,
+	WarmupRuns: 1,
+}
 
-		return SortResult{
-			SortedList:  nil,
-			SortedArray: sortedArray,
-			Duration:    duration,
-			Count:       count,
-			IsSorted:    pkg.IsSortedSlice(sortedArray),
-			Analysis:    pkg.CalculateAnalysis(count, duration),
-			IsArray:     true,
-		}
-	} else {
-		// Linked list algorithms
-		head := uc.generateRandomList(count)
-		sortedList := uc.executeSortList(algorithmName, head)
-		duration := time.Since(startTime)
+// RunAllBenchmarks executes all predefined benchmarks for an algorithm
+// The results are fitted against candidate complexity models to report the best-fit class
+func (uc *UseCase) RunAllBenchmarks(algorithmName string) (BenchmarkSummary, error) {
+	return uc.RunBenchmarks(algorithmName, DefaultBenchmarkOptions)
+}
 
-		return SortResult{
-			SortedList:  sortedList,
-			SortedArray: nil,
-			Duration:    duration,
-			Count:       count,
-			IsSorted:    uc.verifySorted(sortedList),
-			Analysis:    pkg.CalculateAnalysis(count, duration),
-			IsArray:     false,
+// RunBenchmarks benchmarks an algorithm on each size with warm-up and repeated runs
+// Only the sort itself is timed: data generation and copying happen before the clock starts
+// The duration of each result is the median of its runs and IsSorted holds only if every timed run sorted correctly
+func (uc *UseCase) RunBenchmarks(algorithmName string, options BenchmarkOptions) (BenchmarkSummary, error) {
+	algorithm, err := uc.registry.Lookup(algorithmName)
+	if err != nil {
+		return BenchmarkSummary{}, err
+	}
+	if options.Runs < 1 {
+		return BenchmarkSummary{}, fmt.Errorf("runs must be at least 1, got %d", options.Runs)
+	}
+	if options.WarmupRuns < 0 {
+		return BenchmarkSummary{}, fmt.Errorf("warm-up runs cannot be negative, got %d", options.WarmupRuns)
+	}
+
+	// Parallel algorithms also time their sequential baseline on the same inputs to report a speedup
+	var baseline *Algorithm
+	if algorithm.Baseline != "" {
+		sequential, err := uc.registry.Lookup(algorithm.Baseline)
+		if err != nil {
+			return BenchmarkSummary{}, err
 		}
+		baseline = &sequential
 	}
-}
 
-// BenchmarkSort runs a benchmark with predefined size
-func (uc *UseCase) BenchmarkSort(algorithmName string, count int) SortResult {
-	return uc.CustomRandomSort(algorithmName, count)
-}
+	results := make([]BenchmarkResult, 0, len(options.Sizes))
 
-// RunAllBenchmarks executes all predefined benchmarks for an algorithm
-func (uc *UseCase) RunAllBenchmarks(algorithmName string) BenchmarkSummary {
-	sizes := []int{500, 1000, 5000, 10000}
-	results := make([]BenchmarkResult, 0, len(sizes))
+	for _, count := range options.Sizes {
+		if count < 1 {
+			return BenchmarkSummary{}, fmt.Errorf("benchmark size must be at least 1, got %d", count)
+		}
+
+		for run := 0; run < options.WarmupRuns; run++ {
+			numbers := uc.generator.GenerateDistribution(options.Distribution, count)
+			uc.timeSort(algorithm, numbers)
+			if baseline != nil {
+				uc.timeSort(*baseline, numbers)
+			}
+		}
+
+		samples := make([]time.Duration, 0, options.Runs)
+		baselineSamples := make([]time.Duration, 0, options.Runs)
+		isSorted := true
+
+		for run := 0; run < options.Runs; run++ {
+			numbers := uc.generator.GenerateDistribution(options.Distribution, count)
+			duration, sorted := uc.timeSort(algorithm, numbers)
+			samples = append(samples, duration)
+			isSorted = isSorted && pkg.IsSortedSlice(sorted)
+
+			if baseline != nil {
+				baselineDuration, _ := uc.timeSort(*baseline, numbers)
+				baselineSamples = append(baselineSamples, baselineDuration)
+			}
+		}
 
-	for _, count := range sizes {
-		result := uc.BenchmarkSort(algorithmName, count)
-		results = append(results, BenchmarkResult{
+		stats := pkg.CalculateDurationStats(samples)
+		result := BenchmarkResult{
 			Count:    count,
-			Duration: result.Duration,
-			IsSorted: result.IsSorted,
-		})
+			Duration: stats.Median,
+			IsSorted: isSorted,
+			Stats:    stats,
+		}
+		if baseline != nil && stats.Median > 0 {
+			result.Speedup = float64(pkg.CalculateDurationStats(baselineSamples).Median) / float64(stats.Median)
+		}
+		results = append(results, result)
 	}
 
 	scaling := pkg.CalculateScaling(results)
 
-	return BenchmarkSummary{
-		Results: results,
-		Scaling: scaling,
+	summary := BenchmarkSummary{
+		Algorithm:    algorithm.ID,
+		Class:        algorithm.Class(),
+		Environment:  pkg.CaptureEnvironment(uc.generator.Seed()),
+		Distribution: options.Distribution,
+		Runs:         options.Runs,
+		WarmupRuns:   options.WarmupRuns,
+		Results:      results,
+		Scaling:      scaling,
+		Baseline:     algorithm.Baseline,
 	}
-}
-
-// GetListPartial returns the first n elements of a list as a slice
-func (uc *UseCase) GetListPartial(head *merge_sort.Node, maxCount int) []int {
-	var result []int
-	current := head
-	count := 0
-
-	for current != nil && count < maxCount {
-		result = append(result, current.Value)
-		current = current.Next
-		count++
+	if fit, ok := pkg.FitComplexity(results); ok {
+		summary.Fit = &fit
 	}
 
-	return result
+	return summary, nil
 }
 
-// algorithmUsesArray determines if an algorithm works with arrays or linked lists
-func (uc *UseCase) algorithmUsesArray(algorithmName string) bool {
-	switch algorithmName {
-	case "Quick Sort", "Heap Sort", "Bubble Sort", "Insertion Sort":
-		return true
-	case "Merge Sort":
-		return false
-	default:
-		return false // Default to linked list
+// VisualizeSort generates count numbers shaped by distribution and records every step the algorithm
+// takes to sort them, starting with the input and ending with the sorted result
+func (uc *UseCase) VisualizeSort(algorithmName string, count int, distribution pkg.Distribution) ([]pkg.VisualizationFrame, error) {
+	algorithm, err := uc.registry.Lookup(algorithmName)
+	if err != nil {
+		return nil, err
 	}
-}
-
-// executeSortArray dispatches to array-based sorting algorithms
-func (uc *UseCase) executeSortArray(algorithmName string, arr []int) []int {
-	switch algorithmName {
-	case "Quick Sort":
-		return quick_sort.QuickSort(arr)
-	case "Bubble Sort":
-		return bubble_sort.BubbleSortOptimized(arr)
-	case "Insertion Sort":
-		return insertion_sort.InsertionSort(arr)
-	default:
-		// For future array algorithms, we'll add cases here
-		return quick_sort.QuickSort(arr) // Default fallback
+	if algorithm.SortWithCallback == nil {
+		return nil, fmt.Errorf("%s: %w", algorithm.Name, ErrVisualizationUnsupported)
 	}
-}
 
-// executeSortList dispatches to linked list sorting algorithms
-func (uc *UseCase) executeSortList(algorithmName string, head *merge_sort.Node) *merge_sort.Node {
-	switch algorithmName {
-	case "Merge Sort":
-		return merge_sort.MergeSort(head)
-	default:
-		// For future linked list algorithms, we'll add cases here
-		return merge_sort.MergeSort(head) // Default fallback
-	}
+	numbers := uc.generator.GenerateDistribution(distribution, count)
+	return pkg.RecordFrames(algorithm.SortWithCallback, algorithm.StepKind, numbers), nil
 }
 
-// createListFromSlice creates a linked list from a slice of integers
-func (uc *UseCase) createListFromSlice(values []int) *merge_sort.Node {
-	if len(values) == 0 {
-		return nil
+// TraceSort records every event of the algorithm sorting a copy of numbers
+func (uc *UseCase) TraceSort(algorithmName string, numbers []int) (pkg.Trace, error) {
+	algorithm, err := uc.registry.Lookup(algorithmName)
+	if err != nil {
+		return pkg.Trace{}, err
+	}
+	if algorithm.SortTraced == nil {
+		return pkg.Trace{}, fmt.Errorf("%s: %w", algorithm.Name, ErrTracingUnsupported)
 	}
 
-	head := &merge_sort.Node{Value: values[0]}
-	current := head
+	return pkg.RecordTrace(algorithm.ID, algorithm.SortTraced, numbers), nil
+}
 
-	for i := 1; i < len(values); i++ {
-		newNode := &merge_sort.Node{Value: values[i]}
-		current.Next = newNode
-		current = newNode
+// TraceRandomSort generates count numbers shaped by distribution and records every event
+// of the algorithm sorting them
+func (uc *UseCase) TraceRandomSort(algorithmName string, count int, distribution pkg.Distribution) (pkg.Trace, error) {
+	if _, err := uc.registry.Lookup(algorithmName); err != nil {
+		return pkg.Trace{}, err
 	}
 
-	return head
+	return uc.TraceSort(algorithmName, uc.generator.GenerateDistribution(distribution, count))
 }
 
-// generateRandomList creates a linked list with random numbers from 1 to 1000
-func (uc *UseCase) generateRandomList(count int) *merge_sort.Node {
-	if count <= 0 {
-		return nil
+// executeSort sorts a copy of numbers with the algorithm's Sorter and builds the result
+// The duration is measured from startTime, so callers decide what the timed region includes
+// The operations are counted in a second, untimed run on the same input
+func (uc *UseCase) executeSort(algorithm Algorithm, numbers []int, startTime time.Time) SortResult {
+	count := len(numbers)
+
+	sortedArray := pkg.SortCopy(algorithm.Sorter, numbers)
+	duration := time.Since(startTime)
+
+	return SortResult{
+		Algorithm:   algorithm.ID,
+		Environment: pkg.CaptureEnvironment(0),
+		SortedArray: sortedArray,
+		Duration:    duration,
+		Count:       count,
+		IsSorted:    pkg.IsSortedSlice(sortedArray),
+		Analysis:    pkg.CalculateAnalysis(count, duration, algorithm.Class()),
+		Operations:  uc.countOperations(algorithm, numbers),
 	}
+}
+
+// timeSort sorts a private copy of numbers and reports how long the sort alone took and the sorted values
+// The copy is made before the clock starts; linked list algorithms still build and read back
+// their list inside the timed region, which adds O(n) to their time
+func (uc *UseCase) timeSort(algorithm Algorithm, numbers []int) (time.Duration, []int) {
+	input := make([]int, len(numbers))
+	copy(input, numbers)
+
+	startTime := time.Now()
+	algorithm.Sorter.SortInPlace(input)
+	duration := time.Since(startTime)
 
-	numbers := uc.generator.GenerateIntSliceDefault(count)
-	return uc.createListFromSlice(numbers)
+	return duration, input
 }
 
-// verifySorted checks if a linked list is correctly sorted
-func (uc *UseCase) verifySorted(head *merge_sort.Node) bool {
-	if head == nil || head.Next == nil {
-		return true
+// countOperations runs an instrumented sorter of the algorithm on a fresh copy of numbers
+// It returns nil when the algorithm has no instrumented sorter
+func (uc *UseCase) countOperations(algorithm Algorithm, numbers []int) *pkg.OperationCounter {
+	if algorithm.NewInstrumentedSorter == nil {
+		return nil
 	}
 
-	current := head
-	for current.Next != nil {
-		if current.Value > current.Next.Value {
-			return false
-		}
-		current = current.Next
-	}
+	counter := pkg.NewOperationCounter()
+	input := make([]int, len(numbers))
+	copy(input, numbers)
+	algorithm.NewInstrumentedSorter(counter).SortInPlace(input)
 
-	return true
+	return counter
 }
@@ -0,0 +1,180 @@
+package sorting
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/JoaoVitor615/algorithms-in-go/pkg"
+)
+
+// TestRunBenchmarks tests that every size is measured with the requested number of runs
+func TestRunBenchmarks(t *testing.T) {
+	useCase := NewUseCaseWithSeed(1)
+	options := BenchmarkOptions{Sizes: []int{50, 100, 200}, Runs: 4, WarmupRuns: 2}
+
+	for _, algorithm := range useCase.Algorithms() {
+		t.Run(algorithm.Name, func(t *testing.T) {
+			summary, err := useCase.RunBenchmarks(algorithm.ID, options)
+			if err != nil {
+				t.Fatalf("RunBenchmarks returned unexpected error: %v", err)
+			}
+
+			if summary.Runs != options.Runs || summary.WarmupRuns != options.WarmupRuns {
+				t.Errorf("Runs, WarmupRuns = %d, %d; want %d, %d", summary.Runs, summary.WarmupRuns, options.Runs, options.WarmupRuns)
+			}
+			if len(summary.Results) != len(options.Sizes) {
+				t.Fatalf("len(Results) = %d; want %d", len(summary.Results), len(options.Sizes))
+			}
+			if summary.Baseline != algorithm.Baseline {
+				t.Errorf("Baseline = %q; want %q", summary.Baseline, algorithm.Baseline)
+			}
+
+			for i, result := range summary.Results {
+				if result.Count != options.Sizes[i] || !result.IsSorted {
+					t.Errorf("Results[%d] = %+v; want a sorted result for size %d", i, result, options.Sizes[i])
+				}
+				if result.Stats.Runs != options.Runs {
+					t.Errorf("Results[%d].Stats.Runs = %d; want %d", i, result.Stats.Runs, options.Runs)
+				}
+				if hasSpeedup := result.Speedup > 0; hasSpeedup != (algorithm.Baseline != "") {
+					t.Errorf("Results[%d].Speedup = %.2f; want a speedup only for algorithms with a baseline", i, result.Speedup)
+				}
+				if result.Duration != result.Stats.Median {
+					t.Errorf("Results[%d].Duration = %v; want the median %v", i, result.Duration, result.Stats.Median)
+				}
+				if result.Stats.Min > result.Stats.Median || result.Stats.Median > result.Stats.P95 {
+					t.Errorf("Results[%d].Stats = %+v; want Min <= Median <= P95", i, result.Stats)
+				}
+			}
+		})
+	}
+}
+
+// TestRunBenchmarksInvalidOptions tests that invalid run counts and sizes are rejected
+func TestRunBenchmarksInvalidOptions(t *testing.T) {
+	useCase := NewUseCase()
+
+	testCases := []struct {
+		name    string
+		options BenchmarkOptions
+	}{
+		{name: "Zero runs", options: BenchmarkOptions{Sizes: []int{10}, Runs: 0}},
+		{name: "Negative warm-up", options: BenchmarkOptions{Sizes: []int{10}, Runs: 1, WarmupRuns: -1}},
+		{name: "Zero size", options: BenchmarkOptions{Sizes: []int{0}, Runs: 1}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := useCase.RunBenchmarks("quick", tc.options); err == nil {
+				t.Error("RunBenchmarks returned no error")
+			}
+		})
+	}
+}
+
+// TestSortOperations tests that manual and random sorts count operations like benchmarks do
+func TestSortOperations(t *testing.T) {
+	useCase := NewUseCaseWithSeed(1)
+	sorts := map[string]func(algorithm string) (SortResult, error){
+		"ManualSort": func(algorithm string) (SortResult, error) {
+			return useCase.ManualSort(algorithm, []int{5, 3, 8, 1, 9, 2})
+		},
+		"CustomRandomSort": func(algorithm string) (SortResult, error) {
+			return useCase.CustomRandomSort(algorithm, 100, pkg.Uniform)
+		},
+		"BenchmarkSort": func(algorithm string) (SortResult, error) {
+			return useCase.BenchmarkSort(algorithm, 100, pkg.Uniform)
+		},
+	}
+
+	for name, sort := range sorts {
+		t.Run(name, func(t *testing.T) {
+			for _, algorithm := range useCase.Algorithms() {
+				result, err := sort(algorithm.ID)
+				if err != nil {
+					t.Fatalf("%s: unexpected error: %v", algorithm.ID, err)
+				}
+				if hasOperations := result.Operations != nil; hasOperations != (algorithm.NewInstrumentedSorter != nil) {
+					t.Errorf("%s: Operations = %+v; want counts only for algorithms with an instrumented sorter", algorithm.ID, result.Operations)
+				}
+			}
+		})
+	}
+}
+
+// TestVisualizeSort tests that every visualizable algorithm records steps ending in the sorted input,
+// and that the others report ErrVisualizationUnsupported
+func TestVisualizeSort(t *testing.T) {
+	useCase := NewUseCaseWithSeed(1)
+
+	for _, algorithm := range useCase.Algorithms() {
+		t.Run(algorithm.Name, func(t *testing.T) {
+			frames, err := useCase.VisualizeSort(algorithm.ID, 20, pkg.Uniform)
+			if algorithm.SortWithCallback == nil {
+				if !errors.Is(err, ErrVisualizationUnsupported) {
+					t.Errorf("VisualizeSort() error = %v; want ErrVisualizationUnsupported", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("VisualizeSort returned unexpected error: %v", err)
+			}
+
+			if len(frames) < 3 {
+				t.Fatalf("VisualizeSort returned %d frames; want the input, at least one step and the result", len(frames))
+			}
+			input, result := frames[0].Values, frames[len(frames)-1].Values
+			if !slices.Equal(result, slices.Sorted(slices.Values(input))) {
+				t.Errorf("last frame %v is not the sorted input %v", result, input)
+			}
+			for i, frame := range frames[1 : len(frames)-1] {
+				if frame.First < 0 || frame.First >= len(frame.Values) || frame.Second < 0 || frame.Second >= len(frame.Values) {
+					t.Errorf("step %d highlights invalid indices %d and %d", i+1, frame.First, frame.Second)
+				}
+				if frame.Kind != algorithm.StepKind || (frame.Kind == pkg.MergeStep && frame.First > frame.Second) {
+					t.Errorf("step %d is a %d step from %d to %d; want a %d step", i+1, frame.Kind, frame.First, frame.Second, algorithm.StepKind)
+				}
+			}
+		})
+	}
+
+	if _, err := useCase.VisualizeSort("missing", 10, pkg.Uniform); !errors.Is(err, ErrUnknownAlgorithm) {
+		t.Errorf("VisualizeSort(missing) error = %v; want ErrUnknownAlgorithm", err)
+	}
+}
+
+// TestTraceSort tests that every traced algorithm records a trace that replays to the sorted input
+func TestTraceSort(t *testing.T) {
+	useCase := NewUseCaseWithSeed(1)
+
+	for _, algorithm := range useCase.Algorithms() {
+		t.Run(algorithm.Name, func(t *testing.T) {
+			trace, err := useCase.TraceRandomSort(algorithm.ID, 30, pkg.Uniform)
+			if algorithm.SortTraced == nil {
+				if !errors.Is(err, ErrTracingUnsupported) {
+					t.Errorf("TraceRandomSort() error = %v; want ErrTracingUnsupported", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("TraceRandomSort returned unexpected error: %v", err)
+			}
+
+			summary, err := pkg.SummarizeTrace(trace)
+			if err != nil {
+				t.Fatalf("SummarizeTrace() error = %v", err)
+			}
+			if trace.Algorithm != algorithm.ID || summary.Length != 30 || !summary.Sorted {
+				t.Errorf("trace of %s replays %d numbers to %v; want the 30 sorted inputs", trace.Algorithm, summary.Length, summary.Result)
+			}
+			if summary.Counts[pkg.TraceCompare] == 0 {
+				t.Errorf("trace recorded no comparisons: %v", summary.Counts)
+			}
+		})
+	}
+
+	if _, err := useCase.TraceSort("missing", []int{1}); !errors.Is(err, ErrUnknownAlgorithm) {
+		t.Errorf("TraceSort(missing) error = %v; want ErrUnknownAlgorithm", err)
+	}
+}
@@ -0,0 +1,179 @@
+package sorting
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/JoaoVitor615/algorithms-in-go/pkg"
+)
+
+// VisualizationSpeed is a named delay between two frames of a playing visualization
+type VisualizationSpeed struct {
+	Name  string
+	Delay time.Duration
+}
+
+// VisualizationSpeeds lists the playback speeds from slowest to fastest
+var VisualizationSpeeds = []VisualizationSpeed{
+	{Name: "Very slow", Delay: time.Second},
+	{Name: "Slow", Delay: 500 * time.Millisecond},
+	{Name: "Normal", Delay: 250 * time.Millisecond},
+	{Name: "Fast", Delay: 100 * time.Millisecond},
+	{Name: "Very fast", Delay: 25 * time.Millisecond},
+}
+
+// DefaultVisualizationSpeed is the index in VisualizationSpeeds used until the user picks another
+const DefaultVisualizationSpeed = 2
+
+// visualizationHeight is the number of rows of the bar chart
+const visualizationHeight = 12
+
+// clearScreen moves the cursor home and clears the terminal, so each frame replaces the previous one
+const clearScreen = "\033[H\033[2J"
+
+// visualizationControls is printed under every frame
+const visualizationControls = "Enter: pause/step  p: play/pause  n: next  b: back  +/-: speed  r: restart  q: quit"
+
+// visualizer plays recorded frames, driven by commands typed by the user
+type visualizer struct {
+	name     string
+	frames   []pkg.VisualizationFrame
+	out      io.Writer
+	speed    int // index in VisualizationSpeeds
+	position int // index of the frame on screen
+	playing  bool
+
+	after func(time.Duration) <-chan time.Time // time.After, replaced by tests to control playback
+}
+
+// newVisualizer creates a paused visualizer showing the first frame
+func newVisualizer(name string, frames []pkg.VisualizationFrame, out io.Writer, speed int) *visualizer {
+	return &visualizer{
+		name:   name,
+		frames: frames,
+		out:    out,
+		speed:  min(max(speed, 0), len(VisualizationSpeeds)-1),
+		after:  time.After,
+	}
+}
+
+// run renders frames until the user quits or commands is closed
+// While playing, the next frame is shown after the delay of the current speed and the last frame pauses playback
+func (v *visualizer) run(commands <-chan string) {
+	v.render()
+
+	for {
+		var tick <-chan time.Time
+		if v.playing {
+			tick = v.after(VisualizationSpeeds[v.speed].Delay)
+		}
+
+		select {
+		case command, ok := <-commands:
+			if !ok || !v.handle(command) {
+				return
+			}
+		case <-tick:
+			v.step(1)
+		}
+		v.render()
+	}
+}
+
+// handle applies a command and reports whether the visualization should keep running
+// An empty command, sent by pressing Enter, pauses a playing visualization or steps a paused one
+func (v *visualizer) handle(command string) bool {
+	switch strings.ToLower(command) {
+	case "":
+		if v.playing {
+			v.playing = false
+		} else {
+			v.step(1)
+		}
+	case "p":
+		v.playing = !v.playing
+		if v.playing && v.position == len(v.frames)-1 {
+			v.position = 0
+		}
+	case "n":
+		v.playing = false
+		v.step(1)
+	case "b":
+		v.playing = false
+		v.step(-1)
+	case "+":
+		v.speed = min(v.speed+1, len(VisualizationSpeeds)-1)
+	case "-":
+		v.speed = max(v.speed-1, 0)
+	case "r":
+		v.position = 0
+	case "q":
+		return false
+	}
+	return true
+}
+
+// step moves delta frames forward or back, pausing at either end
+func (v *visualizer) step(delta int) {
+	v.position += delta
+	if v.position <= 0 || v.position >= len(v.frames)-1 {
+		v.position = min(max(v.position, 0), len(v.frames)-1)
+		v.playing = false
+	}
+}
+
+// render draws the current frame with its step counter, description and controls
+func (v *visualizer) render() {
+	frame := v.frames[v.position]
+
+	state := "Paused"
+	if v.playing {
+		state = "Playing"
+	}
+
+	fmt.Fprint(v.out, clearScreen)
+	fmt.Fprintf(v.out, "[   %s - Step %d/%d   ]  %s, speed %s\n\n",
+		v.name, v.position, len(v.frames)-1, state, VisualizationSpeeds[v.speed].Name)
+	fmt.Fprint(v.out, pkg.RenderBars(frame.Values, visualizationHeight, frame.Highlighted()...))
+	fmt.Fprintln(v.out, v.describe(frame))
+	fmt.Fprintln(v.out)
+	fmt.Fprintln(v.out, visualizationControls)
+}
+
+// describe returns one line telling what the frame shows
+func (v *visualizer) describe(frame pkg.VisualizationFrame) string {
+	switch {
+	case v.position == 0:
+		return fmt.Sprintf("Input: %v", frame.Values)
+	case v.position == len(v.frames)-1:
+		return fmt.Sprintf("Sorted: %v", frame.Values)
+	case frame.Kind == pkg.InsertStep:
+		return fmt.Sprintf("Inserted the element from index %d at index %d (value %d)",
+			frame.Second, frame.First, frame.Values[frame.First])
+	case frame.Kind == pkg.MergeStep:
+		return fmt.Sprintf("Merged indices %d to %d: %v",
+			frame.First, frame.Second, frame.Values[frame.First:frame.Second+1])
+	default:
+		return fmt.Sprintf("Swapped indices %d and %d (values now %d and %d)",
+			frame.First, frame.Second, frame.Values[frame.First], frame.Values[frame.Second])
+	}
+}
+
+// readCommands forwards every line typed by the user until "q", which ends the visualization
+// Stopping at "q" leaves no pending read behind to swallow the next menu choice
+func readCommands(input *pkg.InputReader) <-chan string {
+	commands := make(chan string)
+	go func() {
+		defer close(commands)
+		for {
+			command := input.ReadString("")
+			commands <- command
+			if strings.ToLower(command) == "q" {
+				return
+			}
+		}
+	}()
+	return commands
+}
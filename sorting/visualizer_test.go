@@ -0,0 +1,159 @@
+package sorting
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/JoaoVitor615/algorithms-in-go/pkg"
+)
+
+// testFrames records the steps of Bubble Sort on a small reversed input
+func testFrames() []pkg.VisualizationFrame {
+	return pkg.RecordFrames(func(arr []int, callback pkg.StepCallback) []int {
+		result := make([]int, len(arr))
+		copy(result, arr)
+		for i := 0; i < len(result); i++ {
+			for j := 0; j+1 < len(result)-i; j++ {
+				if result[j] > result[j+1] {
+					result[j], result[j+1] = result[j+1], result[j]
+					callback(result, j, j+1)
+				}
+			}
+		}
+		return result
+	}, pkg.SwapStep, []int{3, 2, 1})
+}
+
+// TestVisualizerHandle tests the step, back, speed, restart and quit commands
+func TestVisualizerHandle(t *testing.T) {
+	frames := testFrames()
+	last := len(frames) - 1
+	v := newVisualizer("Bubble Sort", frames, &bytes.Buffer{}, DefaultVisualizationSpeed)
+
+	steps := []struct {
+		command  string
+		position int
+		speed    int
+		playing  bool
+	}{
+		{"", 1, DefaultVisualizationSpeed, false},
+		{"n", 2, DefaultVisualizationSpeed, false},
+		{"b", 1, DefaultVisualizationSpeed, false},
+		{"b", 0, DefaultVisualizationSpeed, false},
+		{"b", 0, DefaultVisualizationSpeed, false},
+		{"+", 0, DefaultVisualizationSpeed + 1, false},
+		{"+", 0, DefaultVisualizationSpeed + 2, false},
+		{"+", 0, len(VisualizationSpeeds) - 1, false},
+		{"-", 0, len(VisualizationSpeeds) - 2, false},
+		{"P", 0, len(VisualizationSpeeds) - 2, true},
+		{"", 0, len(VisualizationSpeeds) - 2, false},
+		{"n", 1, len(VisualizationSpeeds) - 2, false},
+		{"r", 0, len(VisualizationSpeeds) - 2, false},
+		{"unknown", 0, len(VisualizationSpeeds) - 2, false},
+	}
+
+	for i, step := range steps {
+		if !v.handle(step.command) {
+			t.Fatalf("step %d: handle(%q) stopped the visualization", i, step.command)
+		}
+		if v.position != step.position || v.speed != step.speed || v.playing != step.playing {
+			t.Errorf("step %d: after %q position, speed, playing = %d, %d, %t; want %d, %d, %t",
+				i, step.command, v.position, v.speed, v.playing, step.position, step.speed, step.playing)
+		}
+	}
+
+	// Stepping never goes past the result
+	for i := 0; i < last+2; i++ {
+		v.handle("n")
+	}
+	if v.position != last {
+		t.Errorf("position = %d after stepping past the end; want %d", v.position, last)
+	}
+
+	if v.handle("q") {
+		t.Error(`handle("q") kept the visualization running`)
+	}
+}
+
+// TestVisualizerPlayback tests that playing advances one frame per tick and pauses on the sorted result
+func TestVisualizerPlayback(t *testing.T) {
+	frames := testFrames()
+	var out bytes.Buffer
+	v := newVisualizer("Bubble Sort", frames, &out, DefaultVisualizationSpeed)
+
+	ticks := make(chan time.Time)
+	v.after = func(time.Duration) <-chan time.Time { return ticks }
+
+	commands := make(chan string)
+	done := make(chan struct{})
+	go func() {
+		v.run(commands)
+		close(done)
+	}()
+
+	commands <- "p"
+	for i := 1; i < len(frames); i++ {
+		ticks <- time.Time{}
+	}
+	commands <- "q"
+	<-done
+
+	if v.position != len(frames)-1 || v.playing {
+		t.Errorf("position, playing = %d, %t; want %d, false", v.position, v.playing, len(frames)-1)
+	}
+
+	output := out.String()
+	for _, want := range []string{"Input: [3 2 1]", "Swapped indices 0 and 1", "Sorted: [1 2 3]", "Playing", "Paused", visualizationControls} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output does not contain %q", want)
+		}
+	}
+	if got := strings.Count(output, clearScreen); got != len(frames)+1 {
+		t.Errorf("rendered %d frames; want %d, the first one, one for play and one per tick", got, len(frames)+1)
+	}
+}
+
+// TestVisualizerClosedCommands tests that the visualization ends when its commands are closed
+func TestVisualizerClosedCommands(t *testing.T) {
+	commands := make(chan string)
+	close(commands)
+	newVisualizer("Bubble Sort", testFrames(), &bytes.Buffer{}, DefaultVisualizationSpeed).run(commands)
+}
+
+// TestVisualizerDescribe tests that each kind of step is described by what it did
+func TestVisualizerDescribe(t *testing.T) {
+	tests := []struct {
+		name     string
+		frame    pkg.VisualizationFrame
+		expected string
+	}{
+		{
+			name:     "Swap",
+			frame:    pkg.VisualizationFrame{Values: []int{1, 3, 2}, Kind: pkg.SwapStep, First: 0, Second: 1},
+			expected: "Swapped indices 0 and 1 (values now 1 and 3)",
+		},
+		{
+			name:     "Insert",
+			frame:    pkg.VisualizationFrame{Values: []int{1, 3, 2}, Kind: pkg.InsertStep, First: 0, Second: 2},
+			expected: "Inserted the element from index 2 at index 0 (value 1)",
+		},
+		{
+			name:     "Merge",
+			frame:    pkg.VisualizationFrame{Values: []int{4, 1, 2, 3}, Kind: pkg.MergeStep, First: 1, Second: 3},
+			expected: "Merged indices 1 to 3: [1 2 3]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// A middle position, so the frame is neither the input nor the result
+			v := newVisualizer("Test", make([]pkg.VisualizationFrame, 3), &bytes.Buffer{}, DefaultVisualizationSpeed)
+			v.position = 1
+			if got := v.describe(tt.frame); got != tt.expected {
+				t.Errorf("describe() = %q; want %q", got, tt.expected)
+			}
+		})
+	}
+}